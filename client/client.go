@@ -2,11 +2,12 @@
 package client
 
 import (
-	"crypto/tls"
 	"errors"
-	"net/http"
+	"fmt"
 	"net/url"
+	"strings"
 
+	"github.com/go-openapi/runtime"
 	"github.com/go-openapi/strfmt"
 	log "github.com/sirupsen/logrus"
 	"github.com/spf13/viper"
@@ -16,11 +17,12 @@ import (
 
 // ApiClient provides a new API client used to communicate with the REANA server.
 func ApiClient() (*API, error) {
-	// disable certificate security checks
-	http.DefaultTransport.(*http.Transport).TLSClientConfig = &tls.Config{
-		InsecureSkipVerify: true,
-	}
+	return ApiClientWithRetries(viper.GetInt("max-retries"))
+}
 
+// ApiClientWithRetries provides a new API client retrying the failed requests maxRetries times,
+// instead of the configured number of times, e.g. 0 for the requests which must fail fast.
+func ApiClientWithRetries(maxRetries int) (*API, error) {
 	// parse REANA server URL
 	serverURL := viper.GetString("server-url")
 	u, err := url.Parse(serverURL)
@@ -30,14 +32,26 @@ func ApiClient() (*API, error) {
 	if u.Host == "" {
 		return nil, errors.New("environment variable REANA_SERVER_URL is not set")
 	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return nil, fmt.Errorf("unsupported scheme '%s' in server URL '%s'", u.Scheme, serverURL)
+	}
+
+	httpTransport, err := httpTransport()
+	if err != nil {
+		return nil, err
+	}
 
-	// create the transport
-	transport := httptransport.New(u.Host, "", []string{"https"})
+	// create the transport, keeping the path prefix of deployments behind a gateway (e.g. /reana)
+	basePath := strings.TrimSuffix(u.Path, "/")
+	transport := httptransport.New(u.Host, basePath, []string{u.Scheme})
+	transport.Transport = httpTransport
+	// consume unknown content types (e.g. downloaded zip archives) as byte streams
+	transport.Consumers["*/*"] = runtime.ByteStreamConsumer()
 	transport.SetLogger(log.StandardLogger())
 	transport.SetDebug(log.GetLevel() == log.DebugLevel)
 
 	log.Info("Connecting to ", serverURL)
 
-	// create the API client, with the transport
-	return New(transport, strfmt.Default), nil
+	// create the API client, with the transport retrying transient errors
+	return New(newRetryTransport(transport, maxRetries), strfmt.Default), nil
 }
@@ -0,0 +1,114 @@
+package client
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"reanahub/reana-client-go/client/operations"
+	"strings"
+	"testing"
+
+	"github.com/spf13/viper"
+)
+
+func TestApiClient(t *testing.T) {
+	tests := map[string]struct {
+		tls       bool
+		serverURL string // overrides the URL of the test server, which is then used as proxy
+		path      string
+		proxy     bool
+		wantURL   string
+		wantError string
+	}{
+		"https": {tls: true, wantURL: "/api/ping"},
+		"http":  {wantURL: "/api/ping"},
+		"base path": {
+			tls:     true,
+			path:    "/reana/",
+			wantURL: "/reana/api/ping",
+		},
+		"proxy": {
+			serverURL: "http://reana.example:8080/reana",
+			proxy:     true,
+			wantURL:   "http://reana.example:8080/reana/api/ping",
+		},
+		"unsupported scheme": {
+			serverURL: "ftp://reana.example",
+			wantError: "unsupported scheme 'ftp' in server URL 'ftp://reana.example'",
+		},
+		"missing host": {
+			serverURL: "reana.example",
+			wantError: "environment variable REANA_SERVER_URL is not set",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			var requestedURL string
+			handler := func(w http.ResponseWriter, r *http.Request) {
+				requestedURL = r.URL.String()
+				w.Header().Set("Content-Type", "application/json")
+				_, _ = w.Write([]byte(`{"message": "OK", "status": "200"}`))
+			}
+			var server *httptest.Server
+			if test.tls {
+				server = httptest.NewTLSServer(http.HandlerFunc(handler))
+				cert := server.Certificate().Raw
+				caCert := writePEM(t, t.TempDir(), "ca.pem", "CERTIFICATE", cert)
+				viper.Set("ca-cert", caCert)
+			} else {
+				server = httptest.NewServer(http.HandlerFunc(handler))
+			}
+			t.Cleanup(func() {
+				server.Close()
+				viper.Reset()
+			})
+
+			viper.Set("server-url", server.URL+test.path)
+			if test.serverURL != "" {
+				viper.Set("server-url", test.serverURL)
+			}
+			if test.proxy {
+				viper.Set("proxy", server.URL)
+			}
+
+			api, err := ApiClient()
+			if test.wantError != "" {
+				if err == nil || err.Error() != test.wantError {
+					t.Fatalf("Expected error '%s', instead got %v", test.wantError, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Got unexpected error '%s'", err.Error())
+			}
+
+			if _, err := api.Operations.Ping(operations.NewPingParams()); err != nil {
+				t.Fatalf("Got unexpected error '%s'", err.Error())
+			}
+			if requestedURL != test.wantURL {
+				t.Errorf("Expected request to '%s', instead got '%s'", test.wantURL, requestedURL)
+			}
+		})
+	}
+}
+
+func TestProxyFunc(t *testing.T) {
+	req, err := http.NewRequest(http.MethodGet, "https://reana.example/api/ping", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	proxy, err := proxyFunc("http://proxy.example:3128")
+	if err != nil {
+		t.Fatalf("Got unexpected error '%s'", err.Error())
+	}
+	proxyURL, err := proxy(req)
+	if err != nil || proxyURL.String() != "http://proxy.example:3128" {
+		t.Errorf("Expected proxy 'http://proxy.example:3128', instead got %v, %v", proxyURL, err)
+	}
+
+	_, err = proxyFunc("proxy.example")
+	if err == nil || !strings.Contains(err.Error(), "invalid proxy URL 'proxy.example'") {
+		t.Errorf("Expected invalid proxy URL error, instead got %v", err)
+	}
+}
@@ -342,6 +342,9 @@ type SetWorkflowStatusBody struct {
 	// all runs
 	AllRuns bool `json:"all_runs,omitempty"`
 
+	// force stop
+	ForceStop bool `json:"force_stop,omitempty"`
+
 	// workspace
 	Workspace bool `json:"workspace,omitempty"`
 }
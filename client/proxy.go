@@ -0,0 +1,21 @@
+package client
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// proxyFunc returns the function selecting the proxy used for each request.
+// The given proxy URL is used for all the requests when set, otherwise the proxy is selected from the
+// HTTPS_PROXY, HTTP_PROXY and NO_PROXY environment variables.
+func proxyFunc(proxy string) (func(*http.Request) (*url.URL, error), error) {
+	if proxy == "" {
+		return http.ProxyFromEnvironment, nil
+	}
+	proxyURL, err := url.Parse(proxy)
+	if err != nil || proxyURL.Host == "" {
+		return nil, fmt.Errorf("invalid proxy URL '%s'", proxy)
+	}
+	return http.ProxyURL(proxyURL), nil
+}
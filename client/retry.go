@@ -0,0 +1,170 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/go-openapi/runtime"
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	// DefaultMaxRetries number of times an idempotent operation is retried, unless configured.
+	DefaultMaxRetries = 3
+	// defaultBaseDelay delay before the first retry, doubled at every following retry.
+	defaultBaseDelay = 500 * time.Millisecond
+	// defaultMaxDelay maximum delay between retries, also applied to the Retry-After header.
+	defaultMaxDelay = 30 * time.Second
+)
+
+// retryTransport is a runtime.ClientTransport which retries idempotent operations (GET and HEAD
+// requests) failing because of connection errors or transient server errors (5xx and 429 responses).
+// Retries are delayed with a jittered exponential backoff, unless the server asks for a specific
+// delay with the Retry-After header.
+type retryTransport struct {
+	transport  runtime.ClientTransport
+	maxRetries int
+	baseDelay  time.Duration
+	maxDelay   time.Duration
+}
+
+// newRetryTransport creates a retryTransport wrapping the given transport.
+func newRetryTransport(transport runtime.ClientTransport, maxRetries int) *retryTransport {
+	return &retryTransport{
+		transport:  transport,
+		maxRetries: maxRetries,
+		baseDelay:  defaultBaseDelay,
+		maxDelay:   defaultMaxDelay,
+	}
+}
+
+// Submit submits the operation, retrying it when it is idempotent and fails with a transient error.
+func (t *retryTransport) Submit(op *runtime.ClientOperation) (interface{}, error) {
+	if t.maxRetries <= 0 || !isIdempotent(op.Method) {
+		return t.transport.Submit(op)
+	}
+	ctx := op.Context
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	for attempt := 0; ; attempt++ {
+		recorder := &responseRecorder{reader: op.Reader}
+		attemptOp := *op
+		attemptOp.Reader = recorder
+
+		result, err := t.transport.Submit(&attemptOp)
+		if err == nil || attempt >= t.maxRetries || ctx.Err() != nil {
+			return result, err
+		}
+		reason, retryable := recorder.retryReason(err)
+		if !retryable {
+			return result, err
+		}
+
+		delay := t.backoff(attempt, recorder.retryAfter)
+		log.Infof(
+			"Operation %s failed (%s), retrying in %s (%d/%d)",
+			op.ID, reason, delay, attempt+1, t.maxRetries,
+		)
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return result, err
+		case <-timer.C:
+		}
+	}
+}
+
+// backoff returns the delay before the retry following the given attempt.
+// The delay requested by the server with retryAfter is used when valid, otherwise the delay grows
+// exponentially with the attempts, with a random jitter so that clients do not retry all at once.
+func (t *retryTransport) backoff(attempt int, retryAfter string) time.Duration {
+	if delay, ok := parseRetryAfter(retryAfter, time.Now()); ok {
+		if delay > t.maxDelay {
+			return t.maxDelay
+		}
+		return delay
+	}
+
+	delay := t.baseDelay << attempt
+	if delay > t.maxDelay || delay <= 0 {
+		delay = t.maxDelay
+	}
+	// equal jitter: keep half of the delay and randomise the other half
+	half := delay / 2
+	return half + time.Duration(rand.Int63n(int64(half)+1))
+}
+
+// isIdempotent checks if requests with the given HTTP method can be safely retried.
+func isIdempotent(method string) bool {
+	return method == http.MethodGet || method == http.MethodHead
+}
+
+// parseRetryAfter parses the value of the Retry-After header, which is either a number of seconds
+// or an HTTP date, into the delay to wait from now.
+func parseRetryAfter(value string, now time.Time) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(value); err == nil {
+		if seconds < 0 {
+			return 0, false
+		}
+		return time.Duration(seconds) * time.Second, true
+	}
+	date, err := http.ParseTime(value)
+	if err != nil {
+		return 0, false
+	}
+	if delay := date.Sub(now); delay > 0 {
+		return delay, true
+	}
+	return 0, true
+}
+
+// responseRecorder is a runtime.ClientResponseReader which records the status code and the
+// Retry-After header of the response, before reading it with the wrapped reader.
+type responseRecorder struct {
+	reader     runtime.ClientResponseReader
+	code       int
+	retryAfter string
+}
+
+// ReadResponse records the response and reads it with the wrapped reader.
+func (r *responseRecorder) ReadResponse(
+	response runtime.ClientResponse,
+	consumer runtime.Consumer,
+) (interface{}, error) {
+	r.code = response.Code()
+	r.retryAfter = response.GetHeader("Retry-After")
+	return r.reader.ReadResponse(response, consumer)
+}
+
+// retryReason checks if the error of the operation is transient, and returns its reason.
+// Errors are transient when no response was received because of a connection error, or when the
+// response has a 5xx or 429 status code. Timed out requests are not retried, so that --timeout
+// bounds the time spent waiting for the server.
+func (r *responseRecorder) retryReason(err error) (string, bool) {
+	if errors.Is(err, context.DeadlineExceeded) {
+		return "", false
+	}
+	if r.code == 0 {
+		var urlErr *url.Error
+		if errors.As(err, &urlErr) && !urlErr.Timeout() {
+			return urlErr.Err.Error(), true
+		}
+		return "", false
+	}
+	if r.code >= http.StatusInternalServerError || r.code == http.StatusTooManyRequests {
+		return fmt.Sprintf("status %d", r.code), true
+	}
+	return "", false
+}
@@ -0,0 +1,255 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"reanahub/reana-client-go/client/operations"
+	"testing"
+	"time"
+
+	httptransport "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+type testResponse struct {
+	statusCode int
+	headers    map[string]string
+	closeConn  bool // closes the connection without responding
+}
+
+func TestRetryTransport(t *testing.T) {
+	ok := testResponse{statusCode: http.StatusOK}
+	tests := map[string]struct {
+		responses    []testResponse
+		post         bool
+		maxRetries   int
+		wantRequests int
+		wantError    bool
+	}{
+		"success": {
+			responses:    []testResponse{ok},
+			maxRetries:   3,
+			wantRequests: 1,
+		},
+		"server errors": {
+			responses: []testResponse{
+				{statusCode: http.StatusBadGateway},
+				{statusCode: http.StatusInternalServerError},
+				ok,
+			},
+			maxRetries:   3,
+			wantRequests: 3,
+		},
+		"too many requests with retry after": {
+			responses: []testResponse{
+				{
+					statusCode: http.StatusTooManyRequests,
+					headers:    map[string]string{"Retry-After": "0"},
+				},
+				ok,
+			},
+			maxRetries:   3,
+			wantRequests: 2,
+		},
+		"connection error": {
+			responses:    []testResponse{{closeConn: true}, ok},
+			maxRetries:   3,
+			wantRequests: 2,
+		},
+		"retries exhausted": {
+			responses: []testResponse{
+				{statusCode: http.StatusServiceUnavailable},
+				{statusCode: http.StatusServiceUnavailable},
+				{statusCode: http.StatusServiceUnavailable},
+			},
+			maxRetries:   2,
+			wantRequests: 3,
+			wantError:    true,
+		},
+		"retries disabled": {
+			responses:    []testResponse{{statusCode: http.StatusBadGateway}},
+			maxRetries:   0,
+			wantRequests: 1,
+			wantError:    true,
+		},
+		"client error": {
+			responses:    []testResponse{{statusCode: http.StatusNotFound}},
+			maxRetries:   3,
+			wantRequests: 1,
+			wantError:    true,
+		},
+		"non idempotent operation": {
+			responses:    []testResponse{{statusCode: http.StatusBadGateway}},
+			post:         true,
+			maxRetries:   3,
+			wantRequests: 1,
+			wantError:    true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			requests := 0
+			handler := func(w http.ResponseWriter, r *http.Request) {
+				if requests >= len(test.responses) {
+					t.Fatalf("Unexpected request %d", requests+1)
+				}
+				res := test.responses[requests]
+				requests++
+				if res.closeConn {
+					conn, _, err := w.(http.Hijacker).Hijack()
+					if err != nil {
+						t.Fatal(err)
+					}
+					conn.Close()
+					return
+				}
+				w.Header().Set("Content-Type", "application/json")
+				for key, value := range res.headers {
+					w.Header().Set(key, value)
+				}
+				w.WriteHeader(res.statusCode)
+				_, _ = w.Write([]byte(`{"message": "OK", "status": "200"}`))
+			}
+			server := httptest.NewServer(http.HandlerFunc(handler))
+			defer server.Close()
+
+			u, err := url.Parse(server.URL)
+			if err != nil {
+				t.Fatal(err)
+			}
+			transport := &retryTransport{
+				transport:  httptransport.New(u.Host, "", []string{"http"}),
+				maxRetries: test.maxRetries,
+				baseDelay:  time.Millisecond,
+				maxDelay:   10 * time.Millisecond,
+			}
+			api := New(transport, strfmt.Default)
+
+			if test.post {
+				_, err = api.Operations.CreateWorkflow(operations.NewCreateWorkflowParams())
+			} else {
+				_, err = api.Operations.Ping(operations.NewPingParams())
+			}
+			if test.wantError && err == nil {
+				t.Errorf("Expected error, instead got none")
+			}
+			if !test.wantError && err != nil {
+				t.Errorf("Got unexpected error '%s'", err.Error())
+			}
+			if requests != test.wantRequests {
+				t.Errorf("Expected %d requests, instead got %d", test.wantRequests, requests)
+			}
+		})
+	}
+}
+
+func TestRetryReason(t *testing.T) {
+	tests := map[string]struct {
+		code          int
+		err           error
+		wantRetryable bool
+	}{
+		"connection error": {
+			err:           &url.Error{Op: "Get", URL: "https://localhost", Err: io.EOF},
+			wantRetryable: true,
+		},
+		"timeout": {
+			err: &url.Error{Op: "Get", URL: "https://localhost", Err: context.DeadlineExceeded},
+		},
+		"network timeout": {
+			err: &url.Error{
+				Op:  "Get",
+				URL: "https://localhost",
+				Err: &net.DNSError{IsTimeout: true},
+			},
+		},
+		"server error": {
+			code:          http.StatusServiceUnavailable,
+			err:           errors.New("service unavailable"),
+			wantRetryable: true,
+		},
+		"client error": {code: http.StatusNotFound, err: errors.New("not found")},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			recorder := &responseRecorder{code: test.code}
+			if _, retryable := recorder.retryReason(test.err); retryable != test.wantRetryable {
+				t.Errorf("Expected retryable %t, instead got %t", test.wantRetryable, retryable)
+			}
+		})
+	}
+}
+
+func TestBackoff(t *testing.T) {
+	transport := newRetryTransport(nil, DefaultMaxRetries)
+	tests := map[string]struct {
+		attempt    int
+		retryAfter string
+		min, max   time.Duration
+	}{
+		"first retry": {attempt: 0, min: 250 * time.Millisecond, max: 500 * time.Millisecond},
+		"third retry": {attempt: 2, min: time.Second, max: 2 * time.Second},
+		"capped":      {attempt: 20, min: 15 * time.Second, max: 30 * time.Second},
+		"overflow":    {attempt: 100, min: 15 * time.Second, max: 30 * time.Second},
+		"retry after": {retryAfter: "5", min: 5 * time.Second, max: 5 * time.Second},
+		"long retry":  {retryAfter: "3600", min: 30 * time.Second, max: 30 * time.Second},
+		"invalid header": {
+			retryAfter: "soon",
+			min:        250 * time.Millisecond,
+			max:        500 * time.Millisecond,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			for i := 0; i < 10; i++ {
+				delay := transport.backoff(test.attempt, test.retryAfter)
+				if delay < test.min || delay > test.max {
+					t.Fatalf(
+						"Expected delay between %s and %s, instead got %s",
+						test.min, test.max, delay,
+					)
+				}
+			}
+		})
+	}
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	now := time.Date(2022, 8, 1, 12, 0, 0, 0, time.UTC)
+	tests := map[string]struct {
+		value     string
+		wantDelay time.Duration
+		wantOk    bool
+	}{
+		"empty":    {value: "", wantOk: false},
+		"seconds":  {value: "120", wantDelay: 2 * time.Minute, wantOk: true},
+		"negative": {value: "-1", wantOk: false},
+		"http date": {
+			value:     "Mon, 01 Aug 2022 12:00:30 GMT",
+			wantDelay: 30 * time.Second,
+			wantOk:    true,
+		},
+		"past date": {value: "Mon, 01 Aug 2022 11:00:00 GMT", wantDelay: 0, wantOk: true},
+		"invalid":   {value: "tomorrow", wantOk: false},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			delay, ok := parseRetryAfter(test.value, now)
+			if ok != test.wantOk || delay != test.wantDelay {
+				t.Errorf(
+					"Expected %s, %t, instead got %s, %t",
+					test.wantDelay, test.wantOk, delay, ok,
+				)
+			}
+		})
+	}
+}
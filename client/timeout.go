@@ -0,0 +1,13 @@
+package client
+
+import (
+	"time"
+)
+
+// DefaultTimeout timeout waiting for the response of each request, unless configured. Same as the
+// default of the runtime.
+//
+// The timeout starts once the request, including its body, has been sent, and stops when the headers
+// of the response are received, so that uploading or downloading large files is not interrupted.
+// It is applied by the HTTP transport, see newHTTPTransport.
+const DefaultTimeout = 30 * time.Second
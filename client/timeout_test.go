@@ -0,0 +1,82 @@
+package client
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"reanahub/reana-client-go/client/operations"
+	"testing"
+	"time"
+
+	httptransport "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+func TestResponseHeaderTimeout(t *testing.T) {
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("slow") == "headers" {
+			time.Sleep(100 * time.Millisecond)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		w.(http.Flusher).Flush()
+		if r.URL.Query().Get("slow") == "body" {
+			time.Sleep(100 * time.Millisecond)
+		}
+		_, _ = w.Write([]byte(`{"message": "OK", "status": "200"}`))
+	}
+
+	tests := map[string]struct {
+		timeout   time.Duration
+		slow      string
+		wantError bool
+	}{
+		"no timeout":       {timeout: 0, slow: "headers"},
+		"long timeout":     {timeout: time.Minute, slow: "headers"},
+		"exceeded timeout": {timeout: 10 * time.Millisecond, slow: "headers", wantError: true},
+		"slow body":        {timeout: 10 * time.Millisecond, slow: "body"},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(handler))
+			defer server.Close()
+			u, err := url.Parse(server.URL)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			httpTransport, err := newHTTPTransport(transportOptions{timeout: test.timeout})
+			if err != nil {
+				t.Fatal(err)
+			}
+			// the slowness is selected with a query parameter, sent like the access token
+			transport := httptransport.New(u.Host, "", []string{"http"})
+			transport.Transport = &slowQueryTransport{httpTransport, test.slow}
+			api := New(transport, strfmt.Default)
+
+			_, err = api.Operations.Ping(operations.NewPingParams())
+			var urlErr *url.Error
+			if test.wantError && !(errors.As(err, &urlErr) && urlErr.Timeout()) {
+				t.Errorf("Expected timeout error, instead got %v", err)
+			}
+			if !test.wantError && err != nil {
+				t.Errorf("Got unexpected error '%s'", err.Error())
+			}
+		})
+	}
+}
+
+// slowQueryTransport adds the slow query parameter to the requests.
+type slowQueryTransport struct {
+	transport http.RoundTripper
+	slow      string
+}
+
+func (t *slowQueryTransport) RoundTrip(r *http.Request) (*http.Response, error) {
+	query := r.URL.Query()
+	query.Set("slow", t.slow)
+	r.URL.RawQuery = query.Encode()
+	return t.transport.RoundTrip(r)
+}
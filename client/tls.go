@@ -0,0 +1,117 @@
+package client
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/spf13/viper"
+)
+
+// transportOptions represents the TLS, proxy and timeout options of the HTTP transport.
+type transportOptions struct {
+	caCert, clientCert, clientKey string
+	insecure                      bool
+	proxy                         string
+	timeout                       time.Duration
+}
+
+// transports caches the HTTP transports by their options, so that all the API clients of the
+// process share them, together with their idle connections.
+var (
+	transports   = map[transportOptions]*http.Transport{}
+	transportsMu sync.Mutex
+)
+
+// httpTransport returns the HTTP transport for the current TLS, proxy and timeout options, creating
+// it the first time they are used. The certificates are thus only read once per process.
+func httpTransport() (*http.Transport, error) {
+	options := transportOptions{
+		caCert:     viper.GetString("ca-cert"),
+		clientCert: viper.GetString("client-cert"),
+		clientKey:  viper.GetString("client-key"),
+		insecure:   viper.GetBool("insecure"),
+		proxy:      viper.GetString("proxy"),
+		timeout:    viper.GetDuration("timeout"),
+	}
+
+	transportsMu.Lock()
+	defer transportsMu.Unlock()
+	if transport, ok := transports[options]; ok {
+		return transport, nil
+	}
+	transport, err := newHTTPTransport(options)
+	if err != nil {
+		return nil, err
+	}
+	transports[options] = transport
+	return transport, nil
+}
+
+// newHTTPTransport creates a dedicated HTTP transport, based on the default one, which verifies the
+// certificate of the REANA server according to the TLS options and connects through the configured
+// proxy. The timeout limits the wait for the response headers, see DefaultTimeout; zero disables it.
+func newHTTPTransport(options transportOptions) (*http.Transport, error) {
+	tlsConfig, err := newTLSConfig(
+		options.caCert,
+		options.clientCert,
+		options.clientKey,
+		options.insecure,
+	)
+	if err != nil {
+		return nil, err
+	}
+	proxy, err := proxyFunc(options.proxy)
+	if err != nil {
+		return nil, err
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.TLSClientConfig = tlsConfig
+	transport.Proxy = proxy
+	transport.ResponseHeaderTimeout = options.timeout
+	return transport, nil
+}
+
+// newTLSConfig creates the TLS configuration used to connect to the REANA server.
+// The server certificate is verified against the system certificates, or against the ones of the
+// caCert bundle when given, unless insecure is set.
+// The client certificate and key are used for mutual TLS authentication, when given.
+func newTLSConfig(caCert, clientCert, clientKey string, insecure bool) (*tls.Config, error) {
+	tlsConfig := &tls.Config{
+		MinVersion:         tls.VersionTLS12,
+		InsecureSkipVerify: insecure,
+	}
+
+	if caCert != "" {
+		pem, err := os.ReadFile(caCert)
+		if err != nil {
+			return nil, fmt.Errorf("cannot read CA bundle '%s': %v", caCert, err)
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("no certificates found in CA bundle '%s'", caCert)
+		}
+		tlsConfig.RootCAs = pool
+	}
+
+	if clientCert != "" || clientKey != "" {
+		if clientCert == "" || clientKey == "" {
+			return nil, errors.New(
+				"both client certificate and key must be provided for client authentication",
+			)
+		}
+		cert, err := tls.LoadX509KeyPair(clientCert, clientKey)
+		if err != nil {
+			return nil, fmt.Errorf("cannot load client certificate: %v", err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+
+	return tlsConfig, nil
+}
@@ -0,0 +1,212 @@
+package client
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/spf13/viper"
+)
+
+func TestNewTLSConfig(t *testing.T) {
+	dir := t.TempDir()
+	server := httptest.NewTLSServer(http.NotFoundHandler())
+	defer server.Close()
+	caCert := writePEM(t, dir, "ca.pem", "CERTIFICATE", server.Certificate().Raw)
+	emptyCert := filepath.Join(dir, "empty.pem")
+	if err := os.WriteFile(emptyCert, []byte{}, 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := map[string]struct {
+		caCert       string
+		clientCert   string
+		clientKey    string
+		insecure     bool
+		wantError    bool
+		expected     string
+		wantReqError bool
+	}{
+		"verified by default": {
+			wantReqError: true,
+			expected:     "certificate signed by unknown authority",
+		},
+		"ca bundle": {caCert: caCert},
+		"insecure":  {insecure: true},
+		"missing ca bundle": {
+			caCert:    filepath.Join(dir, "missing.pem"),
+			wantError: true,
+			expected:  "cannot read CA bundle",
+		},
+		"empty ca bundle": {
+			caCert:    emptyCert,
+			wantError: true,
+			expected:  "no certificates found in CA bundle",
+		},
+		"client certificate without key": {
+			clientCert: caCert,
+			wantError:  true,
+			expected:   "both client certificate and key must be provided",
+		},
+		"invalid client certificate": {
+			clientCert: caCert,
+			clientKey:  emptyCert,
+			wantError:  true,
+			expected:   "cannot load client certificate",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			tlsConfig, err := newTLSConfig(
+				test.caCert,
+				test.clientCert,
+				test.clientKey,
+				test.insecure,
+			)
+			if test.wantError {
+				if err == nil || !strings.Contains(err.Error(), test.expected) {
+					t.Fatalf("Expected error containing '%s', instead got %v", test.expected, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Got unexpected error '%s'", err.Error())
+			}
+
+			err = get(server.URL, tlsConfig)
+			if test.wantReqError {
+				if err == nil || !strings.Contains(err.Error(), test.expected) {
+					t.Errorf("Expected error containing '%s', instead got %v", test.expected, err)
+				}
+			} else if err != nil {
+				t.Errorf("Got unexpected error '%s'", err.Error())
+			}
+		})
+	}
+}
+
+func TestNewTLSConfigClientCertificate(t *testing.T) {
+	dir := t.TempDir()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "reana-client"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keyDer, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	clientCert := writePEM(t, dir, "client.pem", "CERTIFICATE", der)
+	clientKey := writePEM(t, dir, "client-key.pem", "EC PRIVATE KEY", keyDer)
+
+	parsed, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatal(err)
+	}
+	clientCAs := x509.NewCertPool()
+	clientCAs.AddCert(parsed)
+	server := httptest.NewUnstartedServer(http.NotFoundHandler())
+	server.TLS = &tls.Config{ClientAuth: tls.RequireAndVerifyClientCert, ClientCAs: clientCAs}
+	server.StartTLS()
+	defer server.Close()
+	caCert := writePEM(t, dir, "ca.pem", "CERTIFICATE", server.Certificate().Raw)
+
+	tlsConfig, err := newTLSConfig(caCert, clientCert, clientKey, false)
+	if err != nil {
+		t.Fatalf("Got unexpected error '%s'", err.Error())
+	}
+	if err := get(server.URL, tlsConfig); err != nil {
+		t.Errorf("Got unexpected error '%s'", err.Error())
+	}
+
+	tlsConfig, err = newTLSConfig(caCert, "", "", false)
+	if err != nil {
+		t.Fatalf("Got unexpected error '%s'", err.Error())
+	}
+	if err := get(server.URL, tlsConfig); err == nil {
+		t.Errorf("Expected error when connecting without client certificate")
+	}
+}
+
+func TestApiClientTransport(t *testing.T) {
+	viper.Set("server-url", "https://localhost:8080")
+	viper.Set("insecure", true)
+	t.Cleanup(viper.Reset)
+
+	if _, err := ApiClient(); err != nil {
+		t.Fatalf("Got unexpected error '%s'", err.Error())
+	}
+	tlsConfig := http.DefaultTransport.(*http.Transport).TLSClientConfig
+	if tlsConfig != nil && tlsConfig.InsecureSkipVerify {
+		t.Errorf("Expected the default transport to verify certificates")
+	}
+}
+
+func TestHTTPTransportCache(t *testing.T) {
+	t.Cleanup(viper.Reset)
+
+	viper.Set("insecure", true)
+	first, err := httpTransport()
+	if err != nil {
+		t.Fatalf("Got unexpected error '%s'", err.Error())
+	}
+	second, err := httpTransport()
+	if err != nil {
+		t.Fatalf("Got unexpected error '%s'", err.Error())
+	}
+	if first != second {
+		t.Errorf("Expected the transport to be reused for the same options")
+	}
+
+	viper.Set("insecure", false)
+	third, err := httpTransport()
+	if err != nil {
+		t.Fatalf("Got unexpected error '%s'", err.Error())
+	}
+	if third == first || third.TLSClientConfig.InsecureSkipVerify {
+		t.Errorf("Expected a new transport for different options")
+	}
+}
+
+// get sends a GET request to the given URL, using the given TLS configuration.
+func get(url string, tlsConfig *tls.Config) error {
+	client := &http.Client{Transport: &http.Transport{TLSClientConfig: tlsConfig}}
+	res, err := client.Get(url)
+	if err != nil {
+		return err
+	}
+	return res.Body.Close()
+}
+
+// writePEM writes the given bytes to a PEM file in dir, and returns its path.
+func writePEM(t *testing.T, dir, name, blockType string, bytes []byte) string {
+	path := filepath.Join(dir, name)
+	content := pem.EncodeToMemory(&pem.Block{Type: blockType, Bytes: bytes})
+	if err := os.WriteFile(path, content, 0600); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
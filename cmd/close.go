@@ -63,7 +63,7 @@ func newCloseCmd() *cobra.Command {
 }
 
 func (o *closeOptions) run(cmd *cobra.Command) error {
-	closeParams := operations.NewCloseInteractiveSessionParams()
+	closeParams := operations.NewCloseInteractiveSessionParamsWithContext(cmd.Context())
 	closeParams.SetAccessToken(&o.token)
 	closeParams.SetWorkflowIDOrName(o.workflow)
 
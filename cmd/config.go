@@ -0,0 +1,71 @@
+/*
+This file is part of REANA.
+Copyright (C) 2022 CERN.
+
+REANA is free software; you can redistribute it and/or modify it
+under the terms of the MIT License; see LICENSE file for more details.
+*/
+
+package cmd
+
+import (
+	"reanahub/reana-client-go/pkg/contexts"
+
+	"github.com/spf13/cobra"
+)
+
+// contextsAnnotation annotates the commands that manage the contexts of the configuration file.
+const contextsAnnotation = "contexts"
+
+const configDesc = `
+Manage the configuration file.
+
+The ` + "``config``" + ` commands allow to manage the named contexts stored in the
+configuration file, located by default in $XDG_CONFIG_HOME/reana/config.yaml
+or in the path given by the REANA_CONFIG environment variable. Each context
+holds the server URL, the access token and the workflow to use, which are
+overridden by the respective flags and environment variables.
+
+The context to use is selected with the global ` + "``--context``" + ` flag or the
+REANA_CONTEXT environment variable, falling back to the current context of
+the configuration file.
+
+Examples:
+
+$ reana-client config set server-url https://reana-dev.cern.ch --context dev
+
+$ reana-client config use-context dev
+
+$ reana-client config get-contexts
+`
+
+// newConfigCmd creates a command group to manage the configuration file.
+func newConfigCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:         "config",
+		Short:       "Manage the configuration file.",
+		Long:        configDesc,
+		Args:        cobra.NoArgs,
+		Annotations: map[string]string{contextsAnnotation: ""},
+	}
+
+	cmd.AddCommand(newConfigUseContextCmd())
+	cmd.AddCommand(newConfigGetContextsCmd())
+	cmd.AddCommand(newConfigSetCmd())
+	cmd.AddCommand(newConfigViewCmd())
+
+	return cmd
+}
+
+// loadConfig loads the configuration file, returning its path as well.
+func loadConfig() (*contexts.Config, string, error) {
+	path, err := contexts.DefaultPath()
+	if err != nil {
+		return nil, "", err
+	}
+	cfg, err := contexts.Load(path)
+	if err != nil {
+		return nil, "", err
+	}
+	return cfg, path, nil
+}
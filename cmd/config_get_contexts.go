@@ -0,0 +1,69 @@
+/*
+This file is part of REANA.
+Copyright (C) 2022 CERN.
+
+REANA is free software; you can redistribute it and/or modify it
+under the terms of the MIT License; see LICENSE file for more details.
+*/
+
+package cmd
+
+import (
+	"reanahub/reana-client-go/pkg/displayer"
+	"sort"
+
+	"github.com/spf13/cobra"
+)
+
+const configGetContextsDesc = `
+List the contexts of the configuration file.
+
+The ` + "``config get-contexts``" + ` command lists the contexts stored in the
+configuration file, marking the current one with an asterisk.
+
+Examples:
+
+$ reana-client config get-contexts
+`
+
+// newConfigGetContextsCmd creates a command to list the contexts of the configuration file.
+func newConfigGetContextsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "get-contexts",
+		Short: "List the contexts of the configuration file.",
+		Long:  configGetContextsDesc,
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return configGetContexts(cmd)
+		},
+	}
+
+	return cmd
+}
+
+func configGetContexts(cmd *cobra.Command) error {
+	cfg, _, err := loadConfig()
+	if err != nil {
+		return err
+	}
+
+	var names []string
+	for name := range cfg.Contexts {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	header := []string{"current", "name", "server_url", "workflow"}
+	var rows [][]string
+	for _, name := range names {
+		current := ""
+		if name == cfg.CurrentContext {
+			current = "*"
+		}
+		context := cfg.Contexts[name]
+		rows = append(rows, []string{current, name, context.ServerURL, context.Workflow})
+	}
+	displayer.DisplayTable(header, rows, cmd.OutOrStdout())
+
+	return nil
+}
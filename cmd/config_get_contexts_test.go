@@ -0,0 +1,37 @@
+/*
+This file is part of REANA.
+Copyright (C) 2022 CERN.
+
+REANA is free software; you can redistribute it and/or modify it
+under the terms of the MIT License; see LICENSE file for more details.
+*/
+
+package cmd
+
+import (
+	"testing"
+)
+
+func TestConfigGetContexts(t *testing.T) {
+	tests := map[string]ConfigCmdParams{
+		"contexts": {
+			config: &testConfig,
+			args:   []string{"get-contexts"},
+			expected: []string{
+				"CURRENT   NAME   SERVER_URL                  WORKFLOW",
+				"*         dev    https://reana-dev.cern.ch",
+				"          prod   https://reana.cern.ch       analysis",
+			},
+		},
+		"no configuration file": {
+			args:     []string{"get-contexts"},
+			expected: []string{"CURRENT   NAME   SERVER_URL   WORKFLOW"},
+		},
+	}
+
+	for name, params := range tests {
+		t.Run(name, func(t *testing.T) {
+			testConfigCmdRun(t, params)
+		})
+	}
+}
@@ -0,0 +1,100 @@
+/*
+This file is part of REANA.
+Copyright (C) 2022 CERN.
+
+REANA is free software; you can redistribute it and/or modify it
+under the terms of the MIT License; see LICENSE file for more details.
+*/
+
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"reanahub/reana-client-go/pkg/displayer"
+
+	"github.com/spf13/cobra"
+)
+
+const configSetDesc = `
+Set a value of a context.
+
+The ` + "``config set``" + ` command sets the server-url, access-token, workflow or
+credential-store value of the context given by ` + "``--context``" + ` or by the
+REANA_CONTEXT environment variable, or of the current context. The
+credential-store is where ` + "``login``" + ` stores the access token: keyring or file.
+The context is created if it does not exist yet, and becomes the current one
+if there is none.
+
+Examples:
+
+$ reana-client config set server-url https://reana.cern.ch --context prod
+
+$ reana-client config set access-token XXXXXXX --context prod
+
+$ reana-client config set workflow myanalysis
+
+$ reana-client config set credential-store keyring --context prod
+`
+
+type configSetOptions struct {
+	context string
+	key     string
+	value   string
+}
+
+// newConfigSetCmd creates a command to set a value of a context.
+func newConfigSetCmd() *cobra.Command {
+	o := &configSetOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "set KEY VALUE",
+		Short: "Set a value of a context.",
+		Long:  configSetDesc,
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			context, err := selectedContext(cmd)
+			if err != nil {
+				return err
+			}
+			o.context = context
+			o.key = args[0]
+			o.value = args[1]
+			return o.run(cmd)
+		},
+	}
+
+	return cmd
+}
+
+func (o *configSetOptions) run(cmd *cobra.Command) error {
+	cfg, path, err := loadConfig()
+	if err != nil {
+		return err
+	}
+
+	context := o.context
+	if context == "" {
+		context = cfg.CurrentContext
+	}
+	if context == "" {
+		return errors.New(
+			"no context is selected, please provide one with the --context flag",
+		)
+	}
+
+	if err := cfg.Set(context, o.key, o.value); err != nil {
+		return err
+	}
+	if err := cfg.Save(path); err != nil {
+		return err
+	}
+
+	displayer.DisplayMessage(
+		fmt.Sprintf("Value of %s was set in context %s.", o.key, context),
+		displayer.Success,
+		false,
+		cmd.OutOrStdout(),
+	)
+	return nil
+}
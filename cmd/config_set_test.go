@@ -0,0 +1,81 @@
+/*
+This file is part of REANA.
+Copyright (C) 2022 CERN.
+
+REANA is free software; you can redistribute it and/or modify it
+under the terms of the MIT License; see LICENSE file for more details.
+*/
+
+package cmd
+
+import (
+	"reanahub/reana-client-go/pkg/contexts"
+	"testing"
+)
+
+func TestConfigSet(t *testing.T) {
+	tests := map[string]ConfigCmdParams{
+		"current context": {
+			config:   &testConfig,
+			args:     []string{"set", "workflow", "myanalysis"},
+			expected: []string{"Value of workflow was set in context dev."},
+			checkConfig: func(t *testing.T, cfg *contexts.Config) {
+				if cfg.Contexts["dev"].Workflow != "myanalysis" {
+					t.Errorf("Expected workflow myanalysis, instead got %v", cfg.Contexts["dev"])
+				}
+			},
+		},
+		"new context": {
+			config: &testConfig,
+			args: []string{
+				"set", "server-url", "https://reana-staging.cern.ch", "--context", "staging",
+			},
+			expected: []string{"Value of server-url was set in context staging."},
+			checkConfig: func(t *testing.T, cfg *contexts.Config) {
+				if cfg.Contexts["staging"].ServerURL != "https://reana-staging.cern.ch" {
+					t.Errorf("Expected new context, instead got %v", cfg.Contexts)
+				}
+				if cfg.CurrentContext != "dev" {
+					t.Errorf("Expected current context dev, instead got %s", cfg.CurrentContext)
+				}
+			},
+		},
+		"context from environment": {
+			config:   &testConfig,
+			args:     []string{"set", "workflow", "myanalysis"},
+			env:      map[string]string{"REANA_CONTEXT": "prod"},
+			expected: []string{"Value of workflow was set in context prod."},
+			checkConfig: func(t *testing.T, cfg *contexts.Config) {
+				if cfg.Contexts["prod"].Workflow != "myanalysis" {
+					t.Errorf("Expected workflow in context prod, instead got %v", cfg.Contexts)
+				}
+			},
+		},
+		"first context": {
+			args:     []string{"set", "access-token", "secret", "--context", "dev"},
+			expected: []string{"Value of access-token was set in context dev."},
+			checkConfig: func(t *testing.T, cfg *contexts.Config) {
+				if cfg.CurrentContext != "dev" || cfg.Contexts["dev"].AccessToken != "secret" {
+					t.Errorf("Expected current context dev with token, instead got %v", cfg)
+				}
+			},
+		},
+		"no context selected": {
+			args:      []string{"set", "workflow", "myanalysis"},
+			expected:  []string{"no context is selected"},
+			wantError: true,
+		},
+		"invalid key": {
+			config:    &testConfig,
+			args:      []string{"set", "token", "secret"},
+			expected:  []string{"invalid value for 'key': 'token' is not part of"},
+			wantError: true,
+		},
+	}
+
+	for name, params := range tests {
+		t.Run(name, func(t *testing.T) {
+			testConfigCmdRun(t, params)
+		})
+	}
+}
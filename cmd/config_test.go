@@ -0,0 +1,91 @@
+/*
+This file is part of REANA.
+Copyright (C) 2022 CERN.
+
+REANA is free software; you can redistribute it and/or modify it
+under the terms of the MIT License; see LICENSE file for more details.
+*/
+
+package cmd
+
+import (
+	"path/filepath"
+	"reanahub/reana-client-go/pkg/contexts"
+	"strings"
+	"testing"
+)
+
+// testConfig is the configuration file used by the tests of the config commands.
+var testConfig = contexts.Config{
+	CurrentContext: "dev",
+	Contexts: map[string]contexts.Context{
+		"dev":  {ServerURL: "https://reana-dev.cern.ch", AccessToken: "dev-token"},
+		"prod": {ServerURL: "https://reana.cern.ch", Workflow: "analysis"},
+	},
+}
+
+// writeTestConfig saves the given configuration in a temporary file, which is then used by the commands.
+// Returns the path of the configuration file.
+func writeTestConfig(t *testing.T, cfg *contexts.Config) string {
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	if cfg != nil {
+		if err := cfg.Save(path); err != nil {
+			t.Fatal(err)
+		}
+	}
+	t.Setenv(contexts.ConfigPathEnv, path)
+	return path
+}
+
+// ConfigCmdParams represents the parameters of a config command test.
+type ConfigCmdParams struct {
+	config    *contexts.Config
+	args      []string
+	expected  []string
+	wantError bool
+	env       map[string]string // environment variables set while running the command
+	// checkConfig verifies the configuration file after running the command, when set
+	checkConfig func(t *testing.T, cfg *contexts.Config)
+}
+
+// testConfigCmdRun runs a config command against a temporary configuration file.
+func testConfigCmdRun(t *testing.T, p ConfigCmdParams) {
+	path := writeTestConfig(t, p.config)
+	for key, value := range p.env {
+		t.Setenv(key, value)
+	}
+
+	args := append([]string{"config"}, p.args...)
+	output, err := ExecuteCommand(NewRootCmd(), args...)
+	if !p.wantError && err != nil {
+		t.Fatalf("Got unexpected error '%s'", err.Error())
+	}
+	if p.wantError && err == nil {
+		t.Fatalf("Expected error, instead got '%s'", output)
+	}
+
+	for _, test := range p.expected {
+		if !p.wantError && !strings.Contains(output, test) {
+			t.Errorf("Expected '%s' in output, instead got '%s'", test, output)
+		}
+		if p.wantError && !strings.Contains(err.Error(), test) {
+			t.Errorf("Expected '%s' in error output, instead got '%s'", test, err.Error())
+		}
+	}
+
+	if p.checkConfig != nil {
+		cfg, err := contexts.Load(path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		p.checkConfig(t, cfg)
+	}
+}
+
+func TestConfigIgnoresMissingContext(t *testing.T) {
+	testConfigCmdRun(t, ConfigCmdParams{
+		config:   &contexts.Config{CurrentContext: "deleted"},
+		args:     []string{"get-contexts"},
+		expected: []string{"CURRENT   NAME   SERVER_URL   WORKFLOW"},
+	})
+}
@@ -0,0 +1,71 @@
+/*
+This file is part of REANA.
+Copyright (C) 2022 CERN.
+
+REANA is free software; you can redistribute it and/or modify it
+under the terms of the MIT License; see LICENSE file for more details.
+*/
+
+package cmd
+
+import (
+	"fmt"
+	"reanahub/reana-client-go/pkg/displayer"
+
+	"github.com/spf13/cobra"
+)
+
+const configUseContextDesc = `
+Set the current context.
+
+The ` + "``config use-context``" + ` command sets the context used by default by
+the other commands, when no ` + "``--context``" + ` flag or REANA_CONTEXT environment
+variable is given.
+
+Examples:
+
+$ reana-client config use-context prod
+`
+
+type configUseContextOptions struct {
+	name string
+}
+
+// newConfigUseContextCmd creates a command to set the current context.
+func newConfigUseContextCmd() *cobra.Command {
+	o := &configUseContextOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "use-context NAME",
+		Short: "Set the current context.",
+		Long:  configUseContextDesc,
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			o.name = args[0]
+			return o.run(cmd)
+		},
+	}
+
+	return cmd
+}
+
+func (o *configUseContextOptions) run(cmd *cobra.Command) error {
+	cfg, path, err := loadConfig()
+	if err != nil {
+		return err
+	}
+	if err := cfg.Use(o.name); err != nil {
+		return err
+	}
+	if err := cfg.Save(path); err != nil {
+		return err
+	}
+
+	displayer.DisplayMessage(
+		fmt.Sprintf("Switched to context %s.", o.name),
+		displayer.Success,
+		false,
+		cmd.OutOrStdout(),
+	)
+	return nil
+}
@@ -0,0 +1,47 @@
+/*
+This file is part of REANA.
+Copyright (C) 2022 CERN.
+
+REANA is free software; you can redistribute it and/or modify it
+under the terms of the MIT License; see LICENSE file for more details.
+*/
+
+package cmd
+
+import (
+	"reanahub/reana-client-go/pkg/contexts"
+	"testing"
+)
+
+func TestConfigUseContext(t *testing.T) {
+	tests := map[string]ConfigCmdParams{
+		"existing context": {
+			config:   &testConfig,
+			args:     []string{"use-context", "prod"},
+			expected: []string{"Switched to context prod."},
+			checkConfig: func(t *testing.T, cfg *contexts.Config) {
+				if cfg.CurrentContext != "prod" {
+					t.Errorf("Expected current context prod, instead got %s", cfg.CurrentContext)
+				}
+			},
+		},
+		"missing context": {
+			config:    &testConfig,
+			args:      []string{"use-context", "staging"},
+			expected:  []string{"context 'staging' does not exist"},
+			wantError: true,
+		},
+		"missing name": {
+			config:    &testConfig,
+			args:      []string{"use-context"},
+			expected:  []string{"accepts 1 arg(s), received 0"},
+			wantError: true,
+		},
+	}
+
+	for name, params := range tests {
+		t.Run(name, func(t *testing.T) {
+			testConfigCmdRun(t, params)
+		})
+	}
+}
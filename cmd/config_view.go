@@ -0,0 +1,68 @@
+/*
+This file is part of REANA.
+Copyright (C) 2022 CERN.
+
+REANA is free software; you can redistribute it and/or modify it
+under the terms of the MIT License; see LICENSE file for more details.
+*/
+
+package cmd
+
+import (
+	"reanahub/reana-client-go/pkg/formatter"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"gopkg.in/yaml.v3"
+)
+
+const configViewDesc = `
+Display the configuration file.
+
+The ` + "``config view``" + ` command displays the content of the configuration
+file, with all its contexts. The access tokens are redacted, unless the
+global ` + "``--show-token``" + ` flag is given.
+
+Examples:
+
+$ reana-client config view
+
+$ reana-client config view --show-token
+`
+
+// newConfigViewCmd creates a command to display the configuration file.
+func newConfigViewCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "view",
+		Short: "Display the configuration file.",
+		Long:  configViewDesc,
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return configView(cmd)
+		},
+	}
+
+	return cmd
+}
+
+func configView(cmd *cobra.Command) error {
+	cfg, _, err := loadConfig()
+	if err != nil {
+		return err
+	}
+
+	if !viper.GetBool("show-token") {
+		for name, context := range cfg.Contexts {
+			context.AccessToken = formatter.RedactToken(context.AccessToken)
+			cfg.Contexts[name] = context
+		}
+	}
+
+	content, err := yaml.Marshal(cfg)
+	if err != nil {
+		return err
+	}
+	cmd.Print(string(content))
+
+	return nil
+}
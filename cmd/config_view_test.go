@@ -0,0 +1,44 @@
+/*
+This file is part of REANA.
+Copyright (C) 2022 CERN.
+
+REANA is free software; you can redistribute it and/or modify it
+under the terms of the MIT License; see LICENSE file for more details.
+*/
+
+package cmd
+
+import (
+	"testing"
+)
+
+func TestConfigView(t *testing.T) {
+	tests := map[string]ConfigCmdParams{
+		"contexts": {
+			config: &testConfig,
+			args:   []string{"view"},
+			expected: []string{
+				"current-context: dev",
+				"    dev:\n        server-url: https://reana-dev.cern.ch\n" +
+					"        access-token: REDACTED\n",
+				"    prod:\n        server-url: https://reana.cern.ch\n" +
+					"        workflow: analysis\n",
+			},
+		},
+		"show token": {
+			config:   &testConfig,
+			args:     []string{"view", "--show-token"},
+			expected: []string{"        access-token: dev-token\n"},
+		},
+		"no configuration file": {
+			args:     []string{"view"},
+			expected: []string{"{}"},
+		},
+	}
+
+	for name, params := range tests {
+		t.Run(name, func(t *testing.T) {
+			testConfigCmdRun(t, params)
+		})
+	}
+}
@@ -0,0 +1,142 @@
+/*
+This file is part of REANA.
+Copyright (C) 2022 CERN.
+
+REANA is free software; you can redistribute it and/or modify it
+under the terms of the MIT License; see LICENSE file for more details.
+*/
+
+package cmd
+
+import (
+	"context"
+	"reanahub/reana-client-go/client"
+	"reanahub/reana-client-go/client/operations"
+	"reanahub/reana-client-go/pkg/specification"
+	"reanahub/reana-client-go/pkg/validator"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+)
+
+const createDesc = `
+Create a new workflow.
+
+The ` + "``create``" + ` command allows to create a new workflow from reana.yaml
+specifications file. The file is expected to be located in the current
+working directory, or supplied via command-line -f option, see examples
+below.
+
+The name of the created workflow is printed in the format name.run_number.
+Use ` + "``--export``" + ` to print it as a shell command that sets REANA_WORKON
+instead, so that the following commands use the new workflow.
+
+Examples:
+
+$ reana-client create
+
+$ reana-client create -n myanalysis
+
+$ reana-client create -n myanalysis -f myreana.yaml
+
+$ eval $(reana-client create -n myanalysis --export)
+`
+
+type createOptions struct {
+	token  string
+	file   string
+	name   string
+	export bool
+}
+
+// newCreateCmd creates a command to create a new workflow.
+func newCreateCmd() *cobra.Command {
+	o := &createOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "create",
+		Short: "Create a new workflow.",
+		Long:  createDesc,
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := validator.ValidateWorkflowName(o.name); err != nil {
+				return err
+			}
+			return o.run(cmd)
+		},
+	}
+
+	f := cmd.Flags()
+	f.StringVarP(&o.token, "access-token", "t", "", "Access token of the current user.")
+	f.StringVarP(
+		&o.file,
+		"file",
+		"f",
+		"reana.yaml",
+		"REANA specification file describing the workflow to execute.",
+	)
+	f.StringVarP(
+		&o.name,
+		"name",
+		"n",
+		"",
+		`Optional name of the workflow. [default is "workflow"]`,
+	)
+	f.BoolVar(
+		&o.export,
+		"export",
+		false,
+		"Print the workflow name as an export of the REANA_WORKON environment variable.",
+	)
+
+	return cmd
+}
+
+func (o *createOptions) run(cmd *cobra.Command) error {
+	spec, err := specification.Load(o.file)
+	if err != nil {
+		return err
+	}
+
+	workflowName, err := createWorkflow(cmd.Context(), o.token, o.name, spec)
+	if err != nil {
+		return err
+	}
+
+	if o.export {
+		cmd.Printf("export REANA_WORKON=%s\n", workflowName)
+	} else {
+		cmd.Println(workflowName)
+	}
+
+	return nil
+}
+
+// createWorkflow creates a new workflow with the given name and specification.
+// Returns the name of the created workflow, in the format name.run_number.
+func createWorkflow(
+	ctx context.Context,
+	token, name string,
+	spec *specification.Specification,
+) (string, error) {
+	if err := spec.CheckWorkflowSpecification(); err != nil {
+		return "", err
+	}
+
+	createParams := operations.NewCreateWorkflowParamsWithContext(ctx)
+	createParams.SetAccessToken(&token)
+	createParams.SetWorkflowName(name)
+	createParams.SetReanaSpecification(spec)
+
+	api, err := client.ApiClient()
+	if err != nil {
+		return "", err
+	}
+	createResp, err := api.Operations.CreateWorkflow(createParams)
+	if err != nil {
+		return "", err
+	}
+
+	log.Infof("Workflow %s created", createResp.Payload.WorkflowName)
+	return createResp.Payload.WorkflowName, nil
+}
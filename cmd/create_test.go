@@ -0,0 +1,96 @@
+/*
+This file is part of REANA.
+Copyright (C) 2022 CERN.
+
+REANA is free software; you can redistribute it and/or modify it
+under the terms of the MIT License; see LICENSE file for more details.
+*/
+
+package cmd
+
+import (
+	"net/http"
+	"testing"
+)
+
+var createPath = "/api/workflows"
+
+func TestCreate(t *testing.T) {
+	tests := map[string]TestCmdParams{
+		"default": {
+			serverResponses: map[string]ServerResponse{
+				createPath: {
+					statusCode:   http.StatusCreated,
+					responseFile: "create_success.json",
+				},
+			},
+			args:     []string{"-f", "../testdata/specs/reana.yaml"},
+			expected: []string{"my_workflow.1"},
+			unwanted: []string{"REANA_WORKON"},
+		},
+		"workflow file": {
+			serverResponses: map[string]ServerResponse{
+				createPath: {
+					statusCode:   http.StatusCreated,
+					responseFile: "create_success.json",
+				},
+			},
+			args: []string{
+				"-f", "../testdata/specs/reana_workflow_file.yaml", "-n", "my_workflow",
+			},
+			expected: []string{"my_workflow.1"},
+		},
+		"export": {
+			serverResponses: map[string]ServerResponse{
+				createPath: {
+					statusCode:   http.StatusCreated,
+					responseFile: "create_success.json",
+				},
+			},
+			args:     []string{"-f", "../testdata/specs/reana.yaml", "--export"},
+			expected: []string{"export REANA_WORKON=my_workflow.1"},
+		},
+		"invalid name": {
+			args:      []string{"-f", "../testdata/specs/reana.yaml", "-n", "my_workflow.1"},
+			expected:  []string{"workflow name 'my_workflow.1' cannot contain dots"},
+			wantError: true,
+		},
+		"missing file": {
+			args:      []string{"-f", "../testdata/specs/missing.yaml"},
+			expected:  []string{"cannot read specification file '../testdata/specs/missing.yaml'"},
+			wantError: true,
+		},
+		"invalid file": {
+			args: []string{"-f", "../testdata/specs/reana_invalid.yaml"},
+			expected: []string{
+				"cannot parse specification file ../testdata/specs/reana_invalid.yaml:3:",
+			},
+			wantError: true,
+		},
+		"snakemake workflow": {
+			args: []string{"-f", "../testdata/specs/reana_snakemake.yaml"},
+			expected: []string{
+				"snakemake workflows are not supported, as the Snakefile cannot be parsed",
+			},
+			wantError: true,
+		},
+		"server error": {
+			serverResponses: map[string]ServerResponse{
+				createPath: {
+					statusCode:   http.StatusInternalServerError,
+					responseFile: "common_internal_server_error.json",
+				},
+			},
+			args:      []string{"-f", "../testdata/specs/reana.yaml"},
+			expected:  []string{"Error while querying"},
+			wantError: true,
+		},
+	}
+
+	for name, params := range tests {
+		t.Run(name, func(t *testing.T) {
+			params.cmd = "create"
+			testCmdRun(t, params)
+		})
+	}
+}
@@ -0,0 +1,101 @@
+/*
+This file is part of REANA.
+Copyright (C) 2022 CERN.
+
+REANA is free software; you can redistribute it and/or modify it
+under the terms of the MIT License; see LICENSE file for more details.
+*/
+
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"reanahub/reana-client-go/pkg/contexts"
+	"reanahub/reana-client-go/pkg/credentials"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"golang.org/x/term"
+)
+
+// passphraseEnv environment variable providing the passphrase of the encrypted credentials file.
+const passphraseEnv = "REANA_CREDENTIALS_PASSPHRASE"
+
+// credentialsFile name of the encrypted credentials file, stored next to the configuration file.
+const credentialsFile = "credentials.enc"
+
+// credentialStore creates the credential store of the given backend, whose encrypted file is
+// stored next to the configuration file in configPath.
+func credentialStore(cmd *cobra.Command, backend, configPath string) (credentials.Store, error) {
+	path := filepath.Join(filepath.Dir(configPath), credentialsFile)
+	return credentials.New(backend, path, func() (string, error) {
+		return readPassphrase(cmd)
+	})
+}
+
+// readPassphrase returns the passphrase of the credentials file, taken from passphraseEnv or asked
+// without echo when the standard input is a terminal.
+func readPassphrase(cmd *cobra.Command) (string, error) {
+	if passphrase, ok := os.LookupEnv(passphraseEnv); ok {
+		return passphrase, nil
+	}
+	in, ok := terminalInput(cmd)
+	if !ok {
+		return "", fmt.Errorf(
+			"the passphrase of the credentials file is required, please set %s",
+			passphraseEnv,
+		)
+	}
+	fmt.Fprint(cmd.ErrOrStderr(), "Passphrase of the credentials file: ")
+	passphrase, err := term.ReadPassword(int(in.Fd()))
+	fmt.Fprintln(cmd.ErrOrStderr())
+	if err != nil {
+		return "", err
+	}
+	return string(passphrase), nil
+}
+
+// saveToken stores the access token of the context in the credential store selected with
+// --credential-store, or previously used by the context, and otherwise in the configuration file.
+func saveToken(cmd *cobra.Command, cfg *contexts.Config, configPath, name, token string) error {
+	backend := viper.GetString("credential-store")
+	if backend == "" {
+		backend = cfg.Contexts[name].CredentialStore
+	}
+	if backend == "" {
+		return cfg.Set(name, "access-token", token)
+	}
+
+	store, err := credentialStore(cmd, backend, configPath)
+	if err != nil {
+		return err
+	}
+	if err := store.Set(name, token); err != nil {
+		return err
+	}
+	if err := cfg.Set(name, "credential-store", backend); err != nil {
+		return err
+	}
+	// the token stored in the configuration file by a previous login is not used anymore
+	return cfg.Unset(name, "access-token")
+}
+
+// deleteToken removes the access token of the context from the configuration file and from the
+// credential store of the context, if any.
+func deleteToken(cmd *cobra.Command, cfg *contexts.Config, configPath, name string) error {
+	if backend := cfg.Contexts[name].CredentialStore; backend != "" {
+		store, err := credentialStore(cmd, backend, configPath)
+		if err != nil {
+			return err
+		}
+		if err := store.Delete(name); err != nil {
+			return err
+		}
+		if err := cfg.Unset(name, "credential-store"); err != nil {
+			return err
+		}
+	}
+	return cfg.Unset(name, "access-token")
+}
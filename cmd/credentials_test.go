@@ -0,0 +1,92 @@
+/*
+This file is part of REANA.
+Copyright (C) 2022 CERN.
+
+REANA is free software; you can redistribute it and/or modify it
+under the terms of the MIT License; see LICENSE file for more details.
+*/
+
+package cmd
+
+import (
+	"errors"
+	"net/http"
+	"os"
+	"path/filepath"
+	"reanahub/reana-client-go/pkg/contexts"
+	"reanahub/reana-client-go/pkg/credentials"
+	"strings"
+	"testing"
+
+	"github.com/spf13/viper"
+)
+
+func TestCredentialStore(t *testing.T) {
+	t.Setenv(passphraseEnv, "secret passphrase")
+	testCmdRun(t, TestCmdParams{
+		cmd: "login",
+		serverResponses: map[string]ServerResponse{
+			youPath: {statusCode: http.StatusOK, responseFile: "login_active.json"},
+		},
+		args:     []string{"--credential-store", "file"},
+		expected: []string{"credentials stored in context dev."},
+		config:   &testConfig,
+	})
+
+	path := os.Getenv(contexts.ConfigPathEnv)
+	store, err := credentialStore(NewRootCmd(), "file", path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cfg, err := contexts.Load(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	context := cfg.Contexts["dev"]
+	if context.AccessToken != "" || context.CredentialStore != "file" {
+		t.Errorf("Expected token in the file credential store, instead got %v", context)
+	}
+	if token, err := store.Get("dev"); err != nil || token != "1234" {
+		t.Errorf("Expected stored token 1234, instead got '%s', %v", token, err)
+	}
+	content, err := os.ReadFile(filepath.Join(filepath.Dir(path), credentialsFile))
+	if err != nil || strings.Contains(string(content), "1234") {
+		t.Errorf("Expected encrypted credentials file, instead got '%s', %v", content, err)
+	}
+
+	t.Run("load token", func(t *testing.T) {
+		viper.Reset()
+		t.Cleanup(viper.Reset)
+		if err := loadContext(newStatusCmd(), ""); err != nil {
+			t.Fatalf("Got unexpected error '%s'", err.Error())
+		}
+		if token := viper.GetString("access-token"); token != "1234" {
+			t.Errorf("Expected access token 1234 from the store, instead got '%s'", token)
+		}
+	})
+
+	t.Run("token not needed", func(t *testing.T) {
+		viper.Reset()
+		t.Cleanup(viper.Reset)
+		t.Setenv(passphraseEnv, "wrong passphrase")
+		if err := loadContext(newVersionCmd(), ""); err != nil {
+			t.Fatalf("Got unexpected error '%s'", err.Error())
+		}
+		if viper.IsSet("access-token") {
+			t.Errorf("Expected no access token, instead got '%s'", viper.GetString("access-token"))
+		}
+	})
+
+	t.Run("logout", func(t *testing.T) {
+		output, err := ExecuteCommand(NewRootCmd(), "logout")
+		if err != nil {
+			t.Fatalf("Got unexpected error '%s'", err.Error())
+		}
+		if !strings.Contains(output, "credentials removed from context dev.") {
+			t.Errorf("Expected logout message, instead got '%s'", output)
+		}
+		if _, err := store.Get("dev"); !errors.Is(err, credentials.ErrNotFound) {
+			t.Errorf("Expected token to be removed from the store, instead got %v", err)
+		}
+	})
+}
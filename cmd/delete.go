@@ -74,6 +74,7 @@ func newDeleteCmd() *cobra.Command {
 
 func (o *deleteOptions) run(cmd *cobra.Command) error {
 	err := workflows.UpdateStatus(
+		cmd.Context(),
 		o.token,
 		o.workflow,
 		"deleted",
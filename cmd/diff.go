@@ -75,7 +75,7 @@ files in the two workspaces are shown.`)
 }
 
 func (o *diffOptions) run(cmd *cobra.Command) error {
-	diffParams := operations.NewGetWorkflowDiffParams()
+	diffParams := operations.NewGetWorkflowDiffParamsWithContext(cmd.Context())
 	diffParams.SetAccessToken(&o.token)
 	diffParams.SetWorkflowIDOrNamea(o.workflowA)
 	diffParams.SetWorkflowIDOrNameb(o.workflowB)
@@ -0,0 +1,229 @@
+/*
+This file is part of REANA.
+Copyright (C) 2022 CERN.
+
+REANA is free software; you can redistribute it and/or modify it
+under the terms of the MIT License; see LICENSE file for more details.
+*/
+
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"math/rand"
+	"mime"
+	"net/http"
+	"os"
+	"path"
+	"path/filepath"
+	"reanahub/reana-client-go/client"
+	"reanahub/reana-client-go/client/operations"
+	"reanahub/reana-client-go/pkg/displayer"
+	"reanahub/reana-client-go/pkg/workflows"
+	"strconv"
+
+	"github.com/go-openapi/runtime"
+	"github.com/spf13/cobra"
+)
+
+const downloadDesc = `
+Download workspace files.
+
+The ` + "``download``" + ` command allows to download workspace files and
+directories. By default, the files specified in the workflow specification
+as outputs are downloaded. You can also specify the individual files you
+would like to download, see examples below. The FILES arguments support the
+same glob patterns as the ` + "``ls``" + ` command, in which case the matching files
+are downloaded as a zip archive.
+
+Examples:
+
+$ reana-client download # download all output files
+
+$ reana-client download mydata.tmp outputs/myplot.png
+
+$ reana-client download 'results/*.png'
+
+$ reana-client download -o ~/myoutputs # download all output files
+`
+
+type downloadOptions struct {
+	token     string
+	workflow  string
+	outputDir string
+	fileNames []string
+}
+
+// newDownloadCmd creates a command to download workspace files.
+func newDownloadCmd() *cobra.Command {
+	o := &downloadOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "download [FILES...]",
+		Short: "Download workspace files.",
+		Long:  downloadDesc,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			o.fileNames = args
+			return o.run(cmd)
+		},
+	}
+
+	f := cmd.Flags()
+	f.StringVarP(&o.token, "access-token", "t", "", "Access token of the current user.")
+	f.StringVarP(
+		&o.workflow,
+		"workflow",
+		"w", "",
+		"Name or UUID of the workflow. Overrides value of REANA_WORKON environment variable.",
+	)
+	f.StringVarP(
+		&o.outputDir,
+		"output-directory",
+		"o",
+		".",
+		"Path to the directory where files will be downloaded.",
+	)
+
+	return cmd
+}
+
+func (o *downloadOptions) run(cmd *cobra.Command) error {
+	fileNames := o.fileNames
+	if len(fileNames) == 0 {
+		spec, err := workflows.GetSpecification(cmd.Context(), o.token, o.workflow)
+		if err != nil {
+			return err
+		}
+		fileNames = append(fileNames, spec.Outputs.Files...)
+		fileNames = append(fileNames, spec.Outputs.Directories...)
+		if len(fileNames) == 0 {
+			return errors.New("no output files are specified in the workflow specification")
+		}
+	}
+
+	api, err := client.ApiClient()
+	if err != nil {
+		return err
+	}
+
+	for _, fileName := range fileNames {
+		savedPath, err := downloadFile(
+			cmd.Context(),
+			api,
+			o.token, o.workflow, fileName, o.outputDir,
+		)
+		if err != nil {
+			return err
+		}
+		displayer.DisplayMessage(
+			fmt.Sprintf("%s has been downloaded to %s", fileName, savedPath),
+			displayer.Success,
+			false,
+			cmd.OutOrStdout(),
+		)
+	}
+
+	return nil
+}
+
+// downloadFile streams the given workspace file to a temporary file in outputDir and moves it to its final
+// location once the download is complete. Returns the path where the file was saved.
+// When the server sends a zip archive instead (e.g. for glob patterns or directories), the archive is saved in
+// outputDir with the name given by the server.
+func downloadFile(
+	ctx context.Context,
+	api *client.API,
+	token, workflow, fileName, outputDir string,
+) (string, error) {
+	if err := os.MkdirAll(outputDir, 0755); err != nil {
+		return "", err
+	}
+	tmpFile, err := createTempFile(outputDir, ".reana-download-")
+	if err != nil {
+		return "", err
+	}
+	defer os.Remove(tmpFile.Name())
+
+	downloadParams := operations.NewDownloadFileParamsWithContext(ctx)
+	downloadParams.SetAccessToken(&token)
+	downloadParams.SetWorkflowIDOrName(workflow)
+	downloadParams.SetFileName(fileName)
+
+	var attachmentName string
+	_, err = api.Operations.DownloadFile(
+		downloadParams,
+		tmpFile,
+		withDownloadReader(&attachmentName),
+	)
+	if closeErr := tmpFile.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return "", err
+	}
+
+	targetPath := filepath.Join(outputDir, filepath.FromSlash(path.Clean("/"+fileName)))
+	if attachmentName != "" && attachmentName != path.Base(fileName) {
+		targetPath = filepath.Join(outputDir, filepath.Base(attachmentName))
+	}
+	if err := os.MkdirAll(filepath.Dir(targetPath), 0755); err != nil {
+		return "", err
+	}
+	if err := os.Rename(tmpFile.Name(), targetPath); err != nil {
+		return "", err
+	}
+
+	return targetPath, nil
+}
+
+// createTempFile creates a new file in dir, whose name starts with prefix, like os.CreateTemp.
+// The file has the permissions of a regular file (0644 minus the umask) instead of 0600, since it is
+// renamed to the downloaded file.
+func createTempFile(dir, prefix string) (*os.File, error) {
+	for i := 0; i < 10000; i++ {
+		name := filepath.Join(dir, prefix+strconv.FormatUint(uint64(rand.Uint32()), 10))
+		file, err := os.OpenFile(name, os.O_RDWR|os.O_CREATE|os.O_EXCL, 0644)
+		if errors.Is(err, fs.ErrExist) {
+			continue
+		}
+		return file, err
+	}
+	return nil, fmt.Errorf("cannot create a temporary file in '%s'", dir)
+}
+
+// downloadReader wraps the response reader of the download operation to stream successful responses as
+// raw bytes, whatever their content type, and to keep the file name sent by the server in the
+// Content-Disposition header, which is not exposed by the generated client.
+type downloadReader struct {
+	runtime.ClientResponseReader
+	attachmentName *string
+}
+
+// ReadResponse stores the attachment file name, if any, and delegates to the wrapped reader.
+func (r downloadReader) ReadResponse(
+	resp runtime.ClientResponse,
+	consumer runtime.Consumer,
+) (any, error) {
+	if resp.Code() == http.StatusOK {
+		_, params, err := mime.ParseMediaType(resp.GetHeader("Content-Disposition"))
+		if err == nil {
+			*r.attachmentName = params["filename"]
+		}
+		consumer = runtime.ByteStreamConsumer()
+	}
+	return r.ClientResponseReader.ReadResponse(resp, consumer)
+}
+
+// withDownloadReader provides a client option that streams the downloaded file and stores the
+// attachment file name in attachmentName.
+func withDownloadReader(attachmentName *string) operations.ClientOption {
+	return func(op *runtime.ClientOperation) {
+		op.Reader = downloadReader{
+			ClientResponseReader: op.Reader,
+			attachmentName:       attachmentName,
+		}
+	}
+}
@@ -0,0 +1,178 @@
+/*
+This file is part of REANA.
+Copyright (C) 2022 CERN.
+
+REANA is free software; you can redistribute it and/or modify it
+under the terms of the MIT License; see LICENSE file for more details.
+*/
+
+package cmd
+
+import (
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+var downloadPathTemplate = "/api/workflows/%s/workspace/%s"
+var specificationPathTemplate = "/api/workflows/%s/specification"
+
+func TestDownload(t *testing.T) {
+	workflowName := "my_workflow"
+	fileHeaders := map[string]string{
+		"Content-Type":        "application/octet-stream",
+		"Content-Disposition": "attachment; filename=data.json",
+	}
+	zipHeaders := map[string]string{
+		"Content-Type":        "application/zip",
+		"Content-Disposition": "attachment; filename=download.zip",
+	}
+
+	tests := map[string]struct {
+		params    TestCmdParams
+		wantFiles []string
+	}{
+		"outputs from specification": {
+			params: TestCmdParams{
+				serverResponses: map[string]ServerResponse{
+					fmt.Sprintf(specificationPathTemplate, workflowName): {
+						statusCode:   http.StatusOK,
+						responseFile: "specification.json",
+					},
+					fmt.Sprintf(downloadPathTemplate, workflowName, "results/data.json"): {
+						statusCode:   http.StatusOK,
+						responseFile: "download_data.json",
+						headers:      fileHeaders,
+					},
+				},
+				args:     []string{"-w", workflowName},
+				expected: []string{"results/data.json has been downloaded to"},
+			},
+			wantFiles: []string{"results/data.json"},
+		},
+		"given files": {
+			params: TestCmdParams{
+				serverResponses: map[string]ServerResponse{
+					fmt.Sprintf(downloadPathTemplate, workflowName, "data.json"): {
+						statusCode:   http.StatusOK,
+						responseFile: "download_data.json",
+						headers:      fileHeaders,
+					},
+				},
+				args:     []string{"-w", workflowName, "data.json"},
+				expected: []string{"data.json has been downloaded to"},
+			},
+			wantFiles: []string{"data.json"},
+		},
+		"json content type": {
+			params: TestCmdParams{
+				serverResponses: map[string]ServerResponse{
+					fmt.Sprintf(downloadPathTemplate, workflowName, "data.json"): {
+						statusCode:   http.StatusOK,
+						responseFile: "download_data.json",
+						headers: map[string]string{
+							"Content-Disposition": "attachment; filename=data.json",
+						},
+					},
+				},
+				args:     []string{"-w", workflowName, "data.json"},
+				expected: []string{"data.json has been downloaded to"},
+			},
+			wantFiles: []string{"data.json"},
+		},
+		"glob pattern": {
+			params: TestCmdParams{
+				serverResponses: map[string]ServerResponse{
+					fmt.Sprintf(downloadPathTemplate, workflowName, "results/*.json"): {
+						statusCode:   http.StatusOK,
+						responseFile: "download_data.json",
+						headers:      zipHeaders,
+					},
+				},
+				args:     []string{"-w", workflowName, "results/*.json"},
+				expected: []string{"results/*.json has been downloaded to", "download.zip"},
+			},
+			wantFiles: []string{"download.zip"},
+		},
+		"no outputs in specification": {
+			params: TestCmdParams{
+				serverResponses: map[string]ServerResponse{
+					fmt.Sprintf(specificationPathTemplate, workflowName): {
+						statusCode:   http.StatusOK,
+						responseFile: "specification_no_outputs.json",
+					},
+				},
+				args:      []string{"-w", workflowName},
+				expected:  []string{"no output files are specified in the workflow specification"},
+				wantError: true,
+			},
+		},
+		"invalid workflow": {
+			params: TestCmdParams{
+				serverResponses: map[string]ServerResponse{
+					fmt.Sprintf(downloadPathTemplate, "invalid", "data.json"): {
+						statusCode:   http.StatusNotFound,
+						responseFile: "common_invalid_workflow.json",
+					},
+				},
+				args: []string{"-w", "invalid", "data.json"},
+				expected: []string{
+					"REANA_WORKON is set to invalid, but that workflow does not exist.",
+				},
+				wantError: true,
+			},
+		},
+	}
+
+	// downloaded files have the permissions of regular files, i.e. 0644 minus the umask
+	regularFile := filepath.Join(t.TempDir(), "regular")
+	if err := os.WriteFile(regularFile, nil, 0644); err != nil {
+		t.Fatal(err)
+	}
+	regularInfo, err := os.Stat(regularFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	regularPerm := regularInfo.Mode().Perm()
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			outputDir := t.TempDir()
+			test.params.cmd = "download"
+			test.params.args = append(test.params.args, "-o", outputDir)
+			testCmdRun(t, test.params)
+
+			for _, file := range test.wantFiles {
+				content, err := os.ReadFile(filepath.Join(outputDir, file))
+				if err != nil {
+					t.Fatalf("Expected file '%s' to be downloaded: %s", file, err.Error())
+				}
+				if string(content) != "{\n  \"events\": 100\n}" {
+					t.Errorf("Unexpected content in '%s': '%s'", file, string(content))
+				}
+				info, err := os.Stat(filepath.Join(outputDir, file))
+				if err != nil {
+					t.Fatal(err)
+				}
+				if info.Mode().Perm() != regularPerm {
+					t.Errorf(
+						"Expected permissions %v for '%s', got %v",
+						regularPerm, file, info.Mode().Perm(),
+					)
+				}
+			}
+
+			entries, err := os.ReadDir(outputDir)
+			if err != nil {
+				t.Fatal(err)
+			}
+			for _, entry := range entries {
+				if filepath.Ext(entry.Name()) == "" && entry.Name() != "results" {
+					t.Errorf("Unexpected file '%s' left in the output directory", entry.Name())
+				}
+			}
+		})
+	}
+}
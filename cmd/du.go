@@ -17,6 +17,8 @@ import (
 	"reanahub/reana-client-go/pkg/displayer"
 	"reanahub/reana-client-go/pkg/filterer"
 
+	"github.com/go-gota/gota/dataframe"
+	"github.com/go-gota/gota/series"
 	"github.com/spf13/cobra"
 )
 
@@ -37,7 +39,9 @@ Examples:
 const duFilterFlagDesc = `Filter results to show only files that match certain filtering
 criteria such as file name or size.
 Use --filter <columm_name>=<column_value> pairs.
-Available filters are 'name' and 'size'.`
+Available filters are 'name' and 'size'.
+The operators !=, >, >=, <, <=, ~ (regex) and !~, and glob patterns, are applied
+client-side, e.g. --filter size>10MB.`
 
 type duOptions struct {
 	token         string
@@ -45,6 +49,7 @@ type duOptions struct {
 	summarize     bool
 	humanReadable bool
 	filter        []string
+	output        string
 }
 
 // newDuCmd creates a command to get workspace disk usage.
@@ -78,6 +83,7 @@ func newDuCmd() *cobra.Command {
 		"Show disk size in human readable format.",
 	)
 	f.StringSliceVar(&o.filter, "filter", []string{}, duFilterFlagDesc)
+	addOutputFlag(f, &o.output)
 	// Remove -h shorthand
 	cmd.PersistentFlags().BoolP("help", "", false, "Help for du")
 
@@ -85,7 +91,13 @@ func newDuCmd() *cobra.Command {
 }
 
 func (o *duOptions) run(cmd *cobra.Command) error {
-	filters, err := filterer.NewFilters(nil, config.DuMultiFilters, o.filter)
+	format, err := outputFormat(cmd.Flags(), o.output, false)
+	if err != nil {
+		return err
+	}
+	filters, err := filterer.NewClientFilters(
+		nil, config.DuMultiFilters, config.DuMultiFilters, o.filter,
+	)
 	if err != nil {
 		return err
 	}
@@ -94,7 +106,7 @@ func (o *duOptions) run(cmd *cobra.Command) error {
 		return err
 	}
 
-	duParams := operations.NewGetWorkflowDiskUsageParams()
+	duParams := operations.NewGetWorkflowDiskUsageParamsWithContext(cmd.Context())
 	duParams.SetAccessToken(&o.token)
 	duParams.SetWorkflowIDOrName(o.workflow)
 	additionalParams := operations.GetWorkflowDiskUsageBody{
@@ -112,41 +124,52 @@ func (o *duOptions) run(cmd *cobra.Command) error {
 		return err
 	}
 
-	err = displayDuPayload(cmd, duResp.Payload, o.humanReadable)
+	err = displayDuPayload(
+		cmd, duResp.Payload, filters.GetClientFilters(), format, o.humanReadable,
+	)
 	if err != nil {
 		return err
 	}
 	return nil
 }
 
-// displayDuPayload displays the disk usage payload, according to the humanReadable flag.
+// displayDuPayload displays the disk usage payload, according to the client filters, the output
+// format and the humanReadable flag.
 func displayDuPayload(
 	cmd *cobra.Command,
 	p *operations.GetWorkflowDiskUsageOKBody,
+	clientFilters []filterer.Expression,
+	format displayer.OutputFormat,
 	humanReadable bool,
 ) error {
 	if len(p.DiskUsageInfo) == 0 {
 		return errors.New("no files matching filter criteria")
 	}
 
-	header := []string{"SIZE", "NAME"}
-	var rows [][]any
-
+	sizeSeries := series.New([]int{}, series.Int, "size")
+	if humanReadable {
+		sizeSeries = series.New([]string{}, series.String, "size")
+	}
+	nameSeries := series.New([]string{}, series.String, "name")
 	for _, diskUsageInfo := range p.DiskUsageInfo {
 		if datautils.HasAnyPrefix(diskUsageInfo.Name, config.FilesBlacklist) {
 			continue
 		}
 
-		var row []any
 		if humanReadable {
-			row = append(row, diskUsageInfo.Size.HumanReadable)
+			sizeSeries.Append(diskUsageInfo.Size.HumanReadable)
 		} else {
-			row = append(row, diskUsageInfo.Size.Raw)
+			sizeSeries.Append(int(diskUsageInfo.Size.Raw))
 		}
-		row = append(row, "."+diskUsageInfo.Name)
-		rows = append(rows, row)
+		nameSeries.Append("." + diskUsageInfo.Name)
 	}
 
-	displayer.DisplayTable(header, rows, cmd.OutOrStdout())
-	return nil
+	df, err := filterer.FilterDataFrame(dataframe.New(sizeSeries, nameSeries), clientFilters)
+	if err != nil {
+		return err
+	}
+	if df.Nrow() == 0 {
+		return errors.New("no files matching filter criteria")
+	}
+	return displayer.DisplayPayloadOutput(df, p, format, cmd.OutOrStdout())
 }
@@ -33,6 +33,26 @@ func TestDu(t *testing.T) {
 				"4608", "./code/gendata.C",
 			},
 		},
+		"csv": {
+			serverResponses: map[string]ServerResponse{
+				fmt.Sprintf(duPathTemplate, workflowName): {
+					statusCode:   http.StatusOK,
+					responseFile: "du_regular_files.json",
+				},
+			},
+			args:     []string{"-w", workflowName, "-o", "csv"},
+			expected: []string{"size,name\n2048,./code/fitdata.C\n4608,./code/gendata.C\n"},
+		},
+		"json": {
+			serverResponses: map[string]ServerResponse{
+				fmt.Sprintf(duPathTemplate, workflowName): {
+					statusCode:   http.StatusOK,
+					responseFile: "du_regular_files.json",
+				},
+			},
+			args:     []string{"-w", workflowName, "-o", "json"},
+			expected: []string{`"name": "./code/fitdata.C",`, `"size": 2048`},
+		},
 		"summarize": {
 			serverResponses: map[string]ServerResponse{
 				fmt.Sprintf(duPathTemplate, workflowName): {
@@ -89,6 +109,27 @@ func TestDu(t *testing.T) {
 				"2048", "./code/gendata.C",
 			},
 		},
+		"client filters": {
+			serverResponses: map[string]ServerResponse{
+				fmt.Sprintf(duPathTemplate, workflowName): {
+					statusCode:   http.StatusOK,
+					responseFile: "du_regular_files.json",
+				},
+			},
+			args:     []string{"-w", workflowName, "--filter", "size>4KB", "-o", "csv"},
+			expected: []string{"size,name\n4608,./code/gendata.C\n"},
+		},
+		"client filters without match": {
+			serverResponses: map[string]ServerResponse{
+				fmt.Sprintf(duPathTemplate, workflowName): {
+					statusCode:   http.StatusOK,
+					responseFile: "du_regular_files.json",
+				},
+			},
+			args:      []string{"-w", workflowName, "--filter", "name~\\.py$"},
+			expected:  []string{"no files matching filter criteria"},
+			wantError: true,
+		},
 		"malformed filters": {
 			args: []string{"-w", workflowName, "--filter", "name"},
 			expected: []string{
@@ -29,6 +29,7 @@ defined by the admin.
 type infoOptions struct {
 	token      string
 	jsonOutput bool
+	output     string
 }
 
 // newInfoCmd creates a command to list cluster general information.
@@ -48,12 +49,18 @@ func newInfoCmd() *cobra.Command {
 	f := cmd.Flags()
 	f.StringVarP(&o.token, "access-token", "t", "", "Access token of the current user.")
 	f.BoolVarP(&o.jsonOutput, "json", "", false, "Get output in JSON format.")
+	addOutputFlag(f, &o.output)
 
 	return cmd
 }
 
 func (o *infoOptions) run(cmd *cobra.Command) error {
-	infoParams := operations.NewInfoParams()
+	format, err := outputFormat(cmd.Flags(), o.output, o.jsonOutput)
+	if err != nil {
+		return err
+	}
+
+	infoParams := operations.NewInfoParamsWithContext(cmd.Context())
 	infoParams.SetAccessToken(o.token)
 
 	api, err := client.ApiClient()
@@ -66,8 +73,9 @@ func (o *infoOptions) run(cmd *cobra.Command) error {
 	}
 
 	p := infoResp.Payload
-	if o.jsonOutput {
-		err := displayer.DisplayJsonOutput(p, cmd.OutOrStdout())
+	// the table format displays the title and the value of each item
+	if format != displayer.TableFormat && format != displayer.WideFormat {
+		err := displayer.DisplayOutput(p, format, cmd.OutOrStdout())
 		if err != nil {
 			return err
 		}
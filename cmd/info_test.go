@@ -49,6 +49,43 @@ func TestInfo(t *testing.T) {
 				"\"title\": \"List of available workspaces\",",
 			},
 		},
+		"csv": {
+			serverResponses: map[string]ServerResponse{
+				infoServerPath: {
+					statusCode:   http.StatusOK,
+					responseFile: "info_small.json",
+				},
+			},
+			args: []string{"-o", "csv"},
+			expected: []string{
+				"kubernetes_max_memory_limit.title,maximum_workspace_retention_period.title\n" +
+					"Maximum allowed memory limit for Kubernetes jobs," +
+					"Maximum retention period in days for workspace files\n",
+			},
+		},
+		"yaml": {
+			serverResponses: map[string]ServerResponse{
+				infoServerPath: {
+					statusCode:   http.StatusOK,
+					responseFile: "info_big.json",
+				},
+			},
+			args: []string{"-o", "yaml"},
+			expected: []string{
+				"compute_backends:\n  title: List of supported compute backends\n" +
+					"  value:\n    - kubernetes\n    - slurmcern\n",
+			},
+		},
+		"jsonpath": {
+			serverResponses: map[string]ServerResponse{
+				infoServerPath: {
+					statusCode:   http.StatusOK,
+					responseFile: "info_big.json",
+				},
+			},
+			args:     []string{"-o", "jsonpath={.compute_backends.value[*]}"},
+			expected: []string{"kubernetes slurmcern"},
+		},
 		"missing fields": {
 			serverResponses: map[string]ServerResponse{
 				infoServerPath: {
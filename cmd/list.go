@@ -9,6 +9,8 @@ under the terms of the MIT License; see LICENSE file for more details.
 package cmd
 
 import (
+	"bytes"
+	"errors"
 	"fmt"
 	"reanahub/reana-client-go/client"
 	"reanahub/reana-client-go/client/operations"
@@ -18,12 +20,16 @@ import (
 	"reanahub/reana-client-go/pkg/filterer"
 	"reanahub/reana-client-go/pkg/formatter"
 	"reanahub/reana-client-go/pkg/workflows"
+	"strings"
+	"time"
 
 	"github.com/go-gota/gota/dataframe"
 	"github.com/go-gota/gota/series"
+	"github.com/jedib0t/go-pretty/v6/text"
 	log "github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
+	"golang.org/x/exp/slices"
 )
 
 const listFormatFlagDesc = `Format output according to column titles or column
@@ -34,7 +40,11 @@ E.g. display workflow with failed status and named test_workflow
 const listFilterFlagDesc = `Filter workflow that contains certain filtering
 criteria. Use --filter
 <columm_name>=<column_value> pairs. Available
-filters are 'name' and 'status'.`
+filters are 'name' and 'status'.
+The displayed columns can also be filtered with the
+operators !=, >, >=, <, <=, ~ (regex) and !~, or
+with glob patterns, e.g. --filter size>10MB,
+--filter created>-7d or --filter name=test*.`
 
 const listDesc = `
 List all workflows and sessions.
@@ -51,6 +61,10 @@ Examples:
   $ reana-client list --sessions
 
   $ reana-client list --verbose --bytes
+
+  $ reana-client list --output csv
+
+  $ reana-client list --watch --until-done --filter name=myanalysis
 `
 
 type listOptions struct {
@@ -60,6 +74,7 @@ type listOptions struct {
 	listSessions         bool
 	formatFilters        []string
 	jsonOutput           bool
+	output               string
 	showAll              bool
 	verbose              bool
 	humanReadable        bool
@@ -71,6 +86,8 @@ type listOptions struct {
 	showDeletedRuns      bool
 	page                 int64
 	size                 int64
+	watch                bool
+	untilDone            bool
 }
 
 // newListCmd creates a new command for listing workflows and sessions.
@@ -94,6 +111,7 @@ func newListCmd() *cobra.Command {
 	f.BoolVarP(&o.listSessions, "sessions", "s", false, "List all open interactive sessions.")
 	f.StringSliceVar(&o.formatFilters, "format", []string{}, listFormatFlagDesc)
 	f.BoolVar(&o.jsonOutput, "json", false, "Get output in JSON format.")
+	addOutputFlag(f, &o.output)
 	f.BoolVar(&o.showAll, "all", false, "Show all workflows including deleted ones.")
 	f.BoolVarP(
 		&o.verbose,
@@ -139,6 +157,19 @@ In case a workflow is in progress, its duration as of now will be shown.`,
 	)
 	f.Int64Var(&o.page, "page", 1, "Results page number (to be used with --size).")
 	f.Int64Var(&o.size, "size", 0, "Number of results per page (to be used with --page).")
+	f.BoolVar(
+		&o.watch,
+		"watch",
+		false,
+		"Refresh the list periodically, highlighting the status changes.",
+	)
+	f.BoolVar(
+		&o.untilDone,
+		"until-done",
+		false,
+		`Stop watching once all the listed workflows have finished, failed,
+been stopped or deleted (to be used with --watch).`,
+	)
 	// Remove -h shorthand
 	cmd.PersistentFlags().BoolP("help", "", false, "Help for du")
 
@@ -150,6 +181,20 @@ In case a workflow is in progress, its duration as of now will be shown.`,
 }
 
 func (o *listOptions) run(cmd *cobra.Command) error {
+	format, err := outputFormat(cmd.Flags(), o.output, o.jsonOutput)
+	if err != nil {
+		return err
+	}
+	if format == displayer.WideFormat {
+		o.verbose = true
+	}
+	if o.watch && format != displayer.TableFormat && format != displayer.WideFormat {
+		return fmt.Errorf("--watch cannot be used with the %s output format", format)
+	}
+	if o.untilDone && (!o.watch || o.listSessions) {
+		return errors.New("--until-done can only be used with --watch, when listing workflows")
+	}
+
 	var runType string
 	if o.listSessions {
 		runType = "interactive"
@@ -157,12 +202,14 @@ func (o *listOptions) run(cmd *cobra.Command) error {
 		runType = "batch"
 	}
 
-	statusFilters, searchFilter, err := parseListFilters(o.filters, o.showDeletedRuns, o.showAll)
+	statusFilters, searchFilter, clientFilters, err := parseListFilters(
+		o.filters, o.showDeletedRuns, o.showAll,
+	)
 	if err != nil {
 		return err
 	}
 
-	listParams := operations.NewGetWorkflowsParams()
+	listParams := operations.NewGetWorkflowsParamsWithContext(cmd.Context())
 	listParams.SetAccessToken(&o.token)
 	listParams.SetType(runType)
 	listParams.SetVerbose(&o.verbose)
@@ -185,10 +232,6 @@ func (o *listOptions) run(cmd *cobra.Command) error {
 	if err != nil {
 		return err
 	}
-	listResp, err := api.Operations.GetWorkflows(listParams)
-	if err != nil {
-		return err
-	}
 
 	header := buildListHeader(
 		runType,
@@ -198,15 +241,26 @@ func (o *listOptions) run(cmd *cobra.Command) error {
 		o.includeDuration,
 	)
 	parsedFormatFilters := formatter.ParseFormatParameters(o.formatFilters, true)
+	if o.watch {
+		return o.watchWorkflows(
+			cmd, api, listParams, header, clientFilters, parsedFormatFilters, format,
+		)
+	}
+
+	listResp, err := api.Operations.GetWorkflows(listParams)
+	if err != nil {
+		return err
+	}
 	err = displayListPayload(
 		cmd,
 		listResp.Payload,
 		header,
+		clientFilters,
 		parsedFormatFilters,
 		o.serverURL,
 		o.token,
 		o.sortColumn,
-		o.jsonOutput,
+		format,
 		o.humanReadable,
 	)
 	if err != nil {
@@ -216,15 +270,164 @@ func (o *listOptions) run(cmd *cobra.Command) error {
 	return nil
 }
 
+// watchWorkflows displays the list of workflows, refreshed every config.CheckInterval seconds,
+// followed by the status changes since the previous refresh. When the output is not a terminal,
+// the list is displayed once, followed by the status changes.
+func (o *listOptions) watchWorkflows(
+	cmd *cobra.Command,
+	api *client.API,
+	listParams *operations.GetWorkflowsParams,
+	header []string,
+	clientFilters []filterer.Expression,
+	formatFilters []formatter.FormatFilter,
+	format displayer.OutputFormat,
+) error {
+	dashboard := displayer.NewDashboard(cmd.OutOrStdout())
+	var previous map[string]string
+	for {
+		listResp, err := api.Operations.GetWorkflows(listParams)
+		if err != nil {
+			return err
+		}
+		df, err := buildListDataFrame(
+			cmd,
+			listResp.Payload,
+			header,
+			clientFilters,
+			o.serverURL,
+			o.token,
+			o.sortColumn,
+			o.humanReadable,
+		)
+		if err != nil {
+			return err
+		}
+		names, statuses := getListStatuses(df)
+		changes := getListStatusChanges(names, previous, statuses)
+
+		table := new(bytes.Buffer)
+		formattedDf, err := formatter.FormatDataFrame(df, formatFilters)
+		if err != nil {
+			return err
+		}
+		if err := displayer.DisplayOutput(formattedDf, format, table); err != nil {
+			return err
+		}
+
+		lines := []string{
+			fmt.Sprintf(
+				"Refreshed at %s, every %ds.", time.Now().Format("15:04:05"), config.CheckInterval,
+			),
+			"",
+		}
+		lines = append(lines, strings.Split(strings.TrimRight(table.String(), "\n"), "\n")...)
+		if len(changes) > 0 {
+			lines = append(lines, "", "Status changes since the previous refresh:")
+			for _, change := range changes {
+				lines = append(lines, text.Colors{text.Bold, text.FgYellow}.Sprint("  "+change))
+			}
+		}
+		summary := strings.Join(changes, "\n")
+		if previous == nil {
+			summary = strings.TrimRight(table.String(), "\n")
+		}
+		dashboard.Update(lines, summary)
+		previous = statuses
+
+		if o.untilDone && haveListedWorkflowsEnded(statuses) {
+			return nil
+		}
+		select {
+		case <-cmd.Context().Done():
+			return cmd.Context().Err()
+		case <-time.After(time.Duration(config.CheckInterval) * time.Second):
+		}
+	}
+}
+
+// getListStatuses returns the full names of the listed workflows, in the order in which they are
+// displayed, and their statuses. The workflows have no status when listing sessions.
+func getListStatuses(df dataframe.DataFrame) ([]string, map[string]string) {
+	statuses := map[string]string{}
+	if !slices.Contains(df.Names(), "status") {
+		return nil, statuses
+	}
+
+	var names []string
+	for i := 0; i < df.Nrow(); i++ {
+		name := df.Col("name").Elem(i).String() + "." + df.Col("run_number").Elem(i).String()
+		names = append(names, name)
+		statuses[name] = df.Col("status").Elem(i).String()
+	}
+	return names, statuses
+}
+
+// getListStatusChanges returns the messages describing the status changes of the workflows since
+// the previous refresh, if any.
+func getListStatusChanges(names []string, previous, current map[string]string) []string {
+	if previous == nil {
+		return nil
+	}
+	var changes []string
+	for _, name := range names {
+		oldStatus, existed := previous[name]
+		if !existed {
+			changes = append(changes, fmt.Sprintf("%s is new and %s", name, current[name]))
+		} else if oldStatus != current[name] {
+			changes = append(
+				changes, fmt.Sprintf("%s changed from %s to %s", name, oldStatus, current[name]),
+			)
+		}
+	}
+	return changes
+}
+
+// haveListedWorkflowsEnded checks if all the listed workflows have ended.
+func haveListedWorkflowsEnded(statuses map[string]string) bool {
+	for _, status := range statuses {
+		if !slices.Contains(config.EndedRunStatuses, status) {
+			return false
+		}
+	}
+	return true
+}
+
 // displayListPayload displays the list payload, according to the given header, filters and output format.
+// The clientFilters are applied on the rows before the formatFilters.
 func displayListPayload(
 	cmd *cobra.Command,
 	p *operations.GetWorkflowsOKBody,
 	header []string,
+	clientFilters []filterer.Expression,
 	formatFilters []formatter.FormatFilter,
 	serverURL, token, sortColumn string,
-	jsonOutput, humanReadable bool,
+	format displayer.OutputFormat,
+	humanReadable bool,
 ) error {
+	df, err := buildListDataFrame(
+		cmd, p, header, clientFilters, serverURL, token, sortColumn, humanReadable,
+	)
+	if err != nil {
+		return err
+	}
+	df, err = formatter.FormatDataFrame(df, formatFilters)
+	if err != nil {
+		return err
+	}
+
+	return displayer.DisplayPayloadOutput(df, p, format, cmd.OutOrStdout())
+}
+
+// buildListDataFrame builds the dataframe of the list payload, according to the given header,
+// sorted by sortColumn and filtered by the clientFilters.
+func buildListDataFrame(
+	cmd *cobra.Command,
+	p *operations.GetWorkflowsOKBody,
+	header []string,
+	clientFilters []filterer.Expression,
+	serverURL, token, sortColumn string,
+	humanReadable bool,
+) (dataframe.DataFrame, error) {
 	var df dataframe.DataFrame
 	for _, col := range header {
 		colSeries := buildListSeries(col, humanReadable)
@@ -255,7 +458,7 @@ func displayListPayload(
 					workflow.Progress.RunFinishedAt,
 				)
 				if err != nil {
-					return err
+					return df, err
 				}
 			case "name":
 				value = name
@@ -273,7 +476,9 @@ func displayListPayload(
 				value = getOptionalStringField(&workflow.SessionType)
 			case "session_uri":
 				if workflow.SessionURI != "" {
-					value = formatter.FormatSessionURI(serverURL, workflow.SessionURI, token)
+					value = formatter.FormatSessionURI(
+						serverURL, workflow.SessionURI, token, viper.GetBool("show-token"),
+					)
 				}
 			case "session_status":
 				value = getOptionalStringField(&workflow.SessionStatus)
@@ -289,22 +494,7 @@ func displayListPayload(
 	if err != nil {
 		cmd.PrintErrf("Warning: sort operation was aborted, %s\n", err)
 	}
-	df, err = formatter.FormatDataFrame(df, formatFilters)
-	if err != nil {
-		return err
-	}
-
-	if jsonOutput {
-		err := displayer.DisplayJsonOutput(df.Maps(), cmd.OutOrStdout())
-		if err != nil {
-			return err
-		}
-	} else {
-		data := formatter.DataFrameToStringData(df)
-		displayer.DisplayTable(df.Names(), data, cmd.OutOrStdout())
-	}
-
-	return nil
+	return filterer.FilterDataFrame(df, clientFilters)
 }
 
 // buildListHeader builds the header of the list table, according to the given runType and whether to include
@@ -342,25 +532,28 @@ func buildListHeader(
 	return header
 }
 
-// parseListFilters takes the filter input and returns status filters as a slice and the remaining filters
-// as a JSON string, according to whether it should show deleted status.
+// parseListFilters takes the filter input and returns status filters as a slice, the remaining
+// filters as a JSON string, according to whether it should show deleted status, and the filters to
+// be applied client-side.
 func parseListFilters(
 	filterInput []string,
 	showDeletedRuns, showAll bool,
-) ([]string, string, error) {
-	filters, err := filterer.NewFilters(nil, config.ListMultiFilters, filterInput)
+) ([]string, string, []filterer.Expression, error) {
+	filters, err := filterer.NewClientFilters(
+		nil, config.ListMultiFilters, config.ListClientFilters, filterInput,
+	)
 	if err != nil {
-		return nil, "", err
+		return nil, "", nil, err
 	}
 
 	statusFilters := config.GetRunStatuses(showDeletedRuns || showAll)
 	err = filters.ValidateValues("status", config.GetRunStatuses(true))
 	if err != nil {
-		return nil, "", err
+		return nil, "", nil, err
 	}
 	userStatusFilters, err := filters.GetMulti("status")
 	if err != nil {
-		return nil, "", err
+		return nil, "", nil, err
 	}
 	if len(userStatusFilters) > 0 {
 		statusFilters = userStatusFilters
@@ -369,10 +562,10 @@ func parseListFilters(
 	jsonFilters := datautils.RemoveFromSlice(config.ListMultiFilters, "status")
 	searchFilter, err := filters.GetJson(jsonFilters)
 	if err != nil {
-		return nil, "", err
+		return nil, "", nil, err
 	}
 
-	return statusFilters, searchFilter, nil
+	return statusFilters, searchFilter, filters.GetClientFilters(), nil
 }
 
 // buildListSeries returns a Series of the right type, according to the column name.
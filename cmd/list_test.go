@@ -137,6 +137,184 @@ func TestList(t *testing.T) {
 ]
 `},
 		},
+		"yaml": {
+			serverResponses: map[string]ServerResponse{
+				listServerPath: {
+					statusCode:   http.StatusOK,
+					responseFile: "list.json",
+				},
+			},
+			args: []string{"-o", "yaml"},
+			expected: []string{
+				"- created: 2022-08-10T17:14:12\n  ended: null\n  name: my_workflow2\n",
+				"  run_number: \"23\"\n  started: 2022-07-28T12:04:52\n  status: finished\n",
+			},
+		},
+		"csv": {
+			serverResponses: map[string]ServerResponse{
+				listServerPath: {
+					statusCode:   http.StatusOK,
+					responseFile: "list.json",
+				},
+			},
+			args: []string{"--output", "csv"},
+			expected: []string{`name,run_number,created,started,ended,status
+my_workflow2,12,2022-08-10T17:14:12,2022-08-10T18:04:52,,running
+my_workflow,23,2022-07-28T12:04:37,2022-07-28T12:04:52,2022-07-28T12:13:10,finished
+`},
+		},
+		"ndjson": {
+			serverResponses: map[string]ServerResponse{
+				listServerPath: {
+					statusCode:   http.StatusOK,
+					responseFile: "list.json",
+				},
+			},
+			args: []string{"-o", "ndjson", "--format", "name,status"},
+			expected: []string{`{"name":"my_workflow2","status":"running"}
+{"name":"my_workflow","status":"finished"}
+`},
+		},
+		"markdown": {
+			serverResponses: map[string]ServerResponse{
+				listServerPath: {
+					statusCode:   http.StatusOK,
+					responseFile: "list.json",
+				},
+			},
+			args: []string{"-o", "markdown", "--format", "name,status"},
+			expected: []string{`| name | status |
+| --- | --- |
+| my_workflow2 | running |
+| my_workflow | finished |
+`},
+		},
+		"wide": {
+			serverResponses: map[string]ServerResponse{
+				listServerPath: {
+					statusCode:   http.StatusOK,
+					responseFile: "list.json",
+				},
+			},
+			args: []string{"-o", "wide"},
+			expected: []string{
+				"NAME", "RUN_NUMBER", "CREATED", "STARTED", "ENDED", "STATUS",
+				"ID", "USER", "SIZE", "PROGRESS", "DURATION",
+				"my_workflow_id", "2/2",
+			},
+		},
+		"go-template": {
+			serverResponses: map[string]ServerResponse{
+				listServerPath: {
+					statusCode:   http.StatusOK,
+					responseFile: "list.json",
+				},
+			},
+			args: []string{
+				"-o", `go-template={{.total}}{{range .items}} {{.name}}:{{.status}}{{end}}`,
+			},
+			expected: []string{"2 my_workflow.23:finished my_workflow2.12:running"},
+		},
+		"jsonpath": {
+			serverResponses: map[string]ServerResponse{
+				listServerPath: {
+					statusCode:   http.StatusOK,
+					responseFile: "list.json",
+				},
+			},
+			args:     []string{"-o", "jsonpath={.items[*].status}"},
+			expected: []string{"finished running"},
+		},
+		"jsonpath without match": {
+			serverResponses: map[string]ServerResponse{
+				listServerPath: {
+					statusCode:   http.StatusOK,
+					responseFile: "list.json",
+				},
+			},
+			args:      []string{"-o", "jsonpath={.items[?(@.status==\"failed\")].name}"},
+			expected:  []string{"did not match anything"},
+			wantError: true,
+		},
+		"jsonpath missing key": {
+			serverResponses: map[string]ServerResponse{
+				listServerPath: {
+					statusCode:   http.StatusOK,
+					responseFile: "list.json",
+				},
+			},
+			args:      []string{"-o", "jsonpath={.workflows[*].name}"},
+			expected:  []string{"workflows is not found"},
+			wantError: true,
+		},
+		"invalid go-template": {
+			args:      []string{"-o", "go-template={{.name"},
+			expected:  []string{"invalid go-template"},
+			wantError: true,
+		},
+		"client filters": {
+			serverResponses: map[string]ServerResponse{
+				listServerPath: {
+					statusCode:   http.StatusOK,
+					responseFile: "list.json",
+				},
+			},
+			args:     []string{"--filter", "created<2022-08-01", "--format", "name,status"},
+			expected: []string{"my_workflow", "finished"},
+			unwanted: []string{"my_workflow2", "running"},
+		},
+		"client filter on a hidden column": {
+			serverResponses: map[string]ServerResponse{
+				listServerPath: {
+					statusCode:   http.StatusOK,
+					responseFile: "list.json",
+				},
+			},
+			args:      []string{"--filter", "size>10MB"},
+			expected:  []string{"invalid value for 'filter column': 'size' is not part of"},
+			wantError: true,
+		},
+		"format with operators": {
+			serverResponses: map[string]ServerResponse{
+				listServerPath: {
+					statusCode:   http.StatusOK,
+					responseFile: "list.json",
+				},
+			},
+			args:     []string{"--format", "name~2$,run_number"},
+			expected: []string{"my_workflow2", "12"},
+			unwanted: []string{"23"},
+		},
+		"watch until done": {
+			serverResponses: map[string]ServerResponse{
+				listServerPath: {
+					statusCode:   http.StatusOK,
+					responseFile: "list.json",
+				},
+			},
+			args: []string{
+				"--watch", "--until-done", "--filter", "status!=running", "--format", "name,status",
+			},
+			expected: []string{"NAME", "STATUS", "my_workflow", "finished"},
+			unwanted: []string{"my_workflow2", "running", "Status changes"},
+		},
+		"until done without watch": {
+			args:      []string{"--until-done"},
+			expected:  []string{"--until-done can only be used with --watch"},
+			wantError: true,
+		},
+		"watch with json": {
+			args:      []string{"--watch", "-o", "json"},
+			expected:  []string{"--watch cannot be used with the json output format"},
+			wantError: true,
+		},
+		"invalid output format": {
+			args: []string{"-o", "xml"},
+			expected: []string{
+				"invalid value for 'output': 'xml' is not part of 'table', 'wide', 'json'",
+			},
+			wantError: true,
+		},
 		"verbose": {
 			serverResponses: map[string]ServerResponse{
 				listServerPath: {
@@ -347,6 +525,7 @@ func TestParseListFilters(t *testing.T) {
 		showAll         bool
 		statusFilters   []string
 		searchFilter    string
+		clientFilters   int
 		wantError       bool
 	}{
 		"no filters": {
@@ -371,6 +550,12 @@ func TestParseListFilters(t *testing.T) {
 			statusFilters: []string{"running", "finished"},
 			searchFilter:  "{\"name\":[\"test\",\"test2\"]}",
 		},
+		"client filters": {
+			filterInput:   []string{"name=test", "status!=failed", "size>10MB", "created>-7d"},
+			statusFilters: config.GetRunStatuses(false),
+			searchFilter:  "{\"name\":[\"test\"]}",
+			clientFilters: 3,
+		},
 		"invalid filter key": {
 			filterInput: []string{"key=value"},
 			wantError:   true,
@@ -379,11 +564,19 @@ func TestParseListFilters(t *testing.T) {
 			filterInput: []string{"status=invalid"},
 			wantError:   true,
 		},
+		"invalid client status filter": {
+			filterInput: []string{"status!=invalid"},
+			wantError:   true,
+		},
+		"invalid regex": {
+			filterInput: []string{"name~(test"},
+			wantError:   true,
+		},
 	}
 
 	for name, test := range tests {
 		t.Run(name, func(t *testing.T) {
-			statusFilters, searchFilter, err := parseListFilters(
+			statusFilters, searchFilter, clientFilters, err := parseListFilters(
 				test.filterInput, test.showDeletedRuns, test.showAll,
 			)
 			if test.wantError {
@@ -400,6 +593,12 @@ func TestParseListFilters(t *testing.T) {
 				if searchFilter != test.searchFilter {
 					t.Errorf("expected search filter to be %s, got %s", test.searchFilter, searchFilter)
 				}
+				if len(clientFilters) != test.clientFilters {
+					t.Errorf(
+						"expected %d client filters, got %d",
+						test.clientFilters, len(clientFilters),
+					)
+				}
 			}
 		})
 	}
@@ -497,3 +696,57 @@ func TestGetProgressField(t *testing.T) {
 		})
 	}
 }
+
+func TestGetListStatusChanges(t *testing.T) {
+	current := map[string]string{"a.1": "finished", "a.2": "running", "b.1": "queued"}
+	names := []string{"a.1", "a.2", "b.1"}
+
+	tests := map[string]struct {
+		previous map[string]string
+		expected []string
+	}{
+		"first refresh": {},
+		"no changes": {
+			previous: map[string]string{"a.1": "finished", "a.2": "running", "b.1": "queued"},
+		},
+		"changes": {
+			previous: map[string]string{"a.1": "running", "a.2": "running"},
+			expected: []string{"a.1 changed from running to finished", "b.1 is new and queued"},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			changes := getListStatusChanges(names, test.previous, current)
+			if !slices.Equal(changes, test.expected) {
+				t.Errorf("expected changes %v, got %v", test.expected, changes)
+			}
+		})
+	}
+}
+
+func TestHaveListedWorkflowsEnded(t *testing.T) {
+	tests := map[string]struct {
+		statuses map[string]string
+		expected bool
+	}{
+		"no workflows": {statuses: map[string]string{}, expected: true},
+		"all ended": {
+			statuses: map[string]string{"a.1": "finished", "a.2": "failed", "a.3": "stopped"},
+			expected: true,
+		},
+		"running": {
+			statuses: map[string]string{"a.1": "finished", "a.2": "running"},
+			expected: false,
+		},
+		"created": {statuses: map[string]string{"a.1": "created"}, expected: false},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			if ended := haveListedWorkflowsEnded(test.statuses); ended != test.expected {
+				t.Errorf("expected %t, got %t", test.expected, ended)
+			}
+		})
+	}
+}
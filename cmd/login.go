@@ -0,0 +1,245 @@
+/*
+This file is part of REANA.
+Copyright (C) 2022 CERN.
+
+REANA is free software; you can redistribute it and/or modify it
+under the terms of the MIT License; see LICENSE file for more details.
+*/
+
+package cmd
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"reanahub/reana-client-go/client"
+	"reanahub/reana-client-go/client/operations"
+	"reanahub/reana-client-go/pkg/config"
+	"reanahub/reana-client-go/pkg/displayer"
+	"reanahub/reana-client-go/pkg/validator"
+	"strings"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"golang.org/x/term"
+)
+
+// defaultContext name of the context created by login when no context is selected.
+const defaultContext = "default"
+
+const loginDesc = `
+Log in to a REANA server.
+
+The ` + "``login``" + ` command asks for the server URL and the access token, unless
+given with ` + "``--server-url``" + ` and ` + "``--access-token``" + `, and checks them
+against the REANA server. The credentials are then stored in the context given
+by ` + "``--context``" + `, in the current context, or in a new context named
+"default", so that the following commands use them.
+
+The access token is stored in the configuration file, unless a credential
+store is selected with ` + "``--credential-store``" + `: the keyring of the user
+(Linux only, through the secret-tool command of libsecret) or a file encrypted
+with a passphrase, taken from REANA_CREDENTIALS_PASSPHRASE or asked
+interactively. The context remembers the credential store of its token.
+
+If the access token is not active yet, the command shows the status of the
+token request instead.
+
+Examples:
+
+$ reana-client login
+
+$ reana-client login --server-url https://reana.cern.ch -t XXXXXXX --context prod
+
+$ reana-client login --credential-store keyring
+`
+
+type loginOptions struct {
+	serverURL string
+	token     string
+	context   string
+}
+
+// newLoginCmd creates a command to log in to a REANA server.
+func newLoginCmd() *cobra.Command {
+	o := &loginOptions{}
+
+	cmd := &cobra.Command{
+		Use:         "login",
+		Short:       "Log in to a REANA server.",
+		Long:        loginDesc,
+		Args:        cobra.NoArgs,
+		Annotations: map[string]string{contextsAnnotation: ""},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			context, err := selectedContext(cmd)
+			if err != nil {
+				return err
+			}
+			o.context = context
+			return o.run(cmd)
+		},
+	}
+
+	f := cmd.Flags()
+	f.StringVar(&o.serverURL, "server-url", "", "URL of the REANA server.")
+	f.StringVarP(&o.token, "access-token", "t", "", "Access token of the current user.")
+	// the access token is asked interactively when not given
+	err := f.SetAnnotation("access-token", "properties", []string{"optional"})
+	if err != nil {
+		log.Debugf("Failed to set access-token annotation: %s", err.Error())
+	}
+
+	return cmd
+}
+
+func (o *loginOptions) run(cmd *cobra.Command) error {
+	cfg, path, err := loadConfig()
+	if err != nil {
+		return err
+	}
+	context := o.context
+	if context == "" {
+		context = cfg.CurrentContext
+	}
+	if context == "" {
+		context = defaultContext
+	}
+
+	in := bufio.NewReader(cmd.InOrStdin())
+	serverURL := o.serverURL
+	if serverURL == "" {
+		defaultURL := viper.GetString("server-url")
+		if defaultURL == "" {
+			defaultURL = cfg.Contexts[context].ServerURL
+		}
+		serverURL, err = prompt(in, cmd.OutOrStdout(), "REANA server URL", defaultURL)
+		if err != nil {
+			return err
+		}
+	}
+	if err := validator.ValidateServerURL(serverURL); err != nil {
+		return err
+	}
+	token := o.token
+	if token == "" {
+		token, err = promptSecret(cmd, in, "Access token")
+		if err != nil {
+			return err
+		}
+	}
+	if err := validator.ValidateAccessToken(token); err != nil {
+		return err
+	}
+
+	// the API client connects to the server being logged in
+	viper.Set("server-url", serverURL)
+	api, err := client.ApiClient()
+	if err != nil {
+		return err
+	}
+	youParams := operations.NewGetYouParamsWithContext(cmd.Context())
+	youParams.SetAccessToken(&token)
+	youResp, err := api.Operations.GetYou(youParams)
+	if err != nil {
+		return err
+	}
+
+	you := youResp.Payload
+	if you.ReanaToken != nil && you.ReanaToken.Status != "" && you.ReanaToken.Status != "active" {
+		return showTokenRequest(cmd, api, token)
+	}
+
+	if err := cfg.Set(context, "server-url", serverURL); err != nil {
+		return err
+	}
+	if err := saveToken(cmd, cfg, path, context, token); err != nil {
+		return err
+	}
+	if err := cfg.Save(path); err != nil {
+		return err
+	}
+
+	message := fmt.Sprintf(
+		"Logged in to %s as %s, credentials stored in context %s.",
+		serverURL, you.Email, context,
+	)
+	displayer.DisplayMessage(
+		message,
+		displayer.Success,
+		false,
+		cmd.OutOrStdout(),
+	)
+	return nil
+}
+
+// showTokenRequest requests an access token for the user, whose token is not active yet, and shows
+// the status of the request. The credentials are not stored, since they cannot be used yet.
+func showTokenRequest(cmd *cobra.Command, api *client.API, token string) error {
+	requestParams := operations.NewRequestTokenParamsWithContext(cmd.Context())
+	requestParams.SetAccessToken(&token)
+	requestResp, err := api.Operations.RequestToken(requestParams)
+	if err != nil {
+		return err
+	}
+
+	status, requestedAt := "requested", ""
+	if t := requestResp.Payload.ReanaToken; t != nil {
+		status, requestedAt = t.Status, t.RequestedAt
+	}
+	displayer.DisplayMessage(
+		"The access token is not active yet, so the credentials were not stored.",
+		displayer.Warning,
+		false,
+		cmd.OutOrStdout(),
+	)
+	message := fmt.Sprintf("Access token request status: %s", status)
+	if requestedAt != "" {
+		message += fmt.Sprintf(" (requested at %s)", requestedAt)
+	}
+	displayer.DisplayMessage(message, displayer.Info, false, cmd.OutOrStdout())
+	return config.EmptyError
+}
+
+// promptSecret asks for a secret value without echoing it when the standard input is a terminal.
+// Otherwise, e.g. when the input is piped, the value is read from in like with prompt.
+func promptSecret(cmd *cobra.Command, in *bufio.Reader, label string) (string, error) {
+	terminal, ok := terminalInput(cmd)
+	if !ok {
+		return prompt(in, cmd.OutOrStdout(), label, "")
+	}
+	fmt.Fprintf(cmd.OutOrStdout(), "%s: ", label)
+	secret, err := term.ReadPassword(int(terminal.Fd()))
+	fmt.Fprintln(cmd.OutOrStdout())
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(string(secret)), nil
+}
+
+// terminalInput returns the standard input of the command if it is a terminal.
+func terminalInput(cmd *cobra.Command) (*os.File, bool) {
+	file, ok := cmd.InOrStdin().(*os.File)
+	if !ok || !term.IsTerminal(int(file.Fd())) {
+		return nil, false
+	}
+	return file, true
+}
+
+// prompt asks for a value, reading a line from in. Returns defaultValue if the line is empty.
+func prompt(in *bufio.Reader, out io.Writer, label, defaultValue string) (string, error) {
+	if defaultValue != "" {
+		fmt.Fprintf(out, "%s [%s]: ", label, defaultValue)
+	} else {
+		fmt.Fprintf(out, "%s: ", label)
+	}
+	line, err := in.ReadString('\n')
+	if err != nil && err != io.EOF {
+		return "", err
+	}
+	if value := strings.TrimSpace(line); value != "" {
+		return value, nil
+	}
+	return defaultValue, nil
+}
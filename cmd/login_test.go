@@ -0,0 +1,175 @@
+/*
+This file is part of REANA.
+Copyright (C) 2022 CERN.
+
+REANA is free software; you can redistribute it and/or modify it
+under the terms of the MIT License; see LICENSE file for more details.
+*/
+
+package cmd
+
+import (
+	"bufio"
+	"bytes"
+	"net/http"
+	"os"
+	"reanahub/reana-client-go/pkg/contexts"
+	"strings"
+	"testing"
+)
+
+var youPath = "/api/you"
+var tokenPath = "/api/token"
+
+func TestLogin(t *testing.T) {
+	tests := map[string]struct {
+		config      *contexts.Config
+		params      TestCmdParams
+		wantContext string
+		wantStored  bool
+	}{
+		"current context": {
+			config: &testConfig,
+			params: TestCmdParams{
+				serverResponses: map[string]ServerResponse{
+					youPath: {statusCode: http.StatusOK, responseFile: "login_active.json"},
+				},
+				input: "\n",
+				expected: []string{
+					"REANA server URL [https://127.0.0.1:",
+					"as john.doe@example.org, credentials stored in context dev.",
+				},
+			},
+			wantContext: "dev",
+			wantStored:  true,
+		},
+		"default context": {
+			params: TestCmdParams{
+				serverResponses: map[string]ServerResponse{
+					youPath: {statusCode: http.StatusOK, responseFile: "login_active.json"},
+				},
+				expected: []string{"credentials stored in context default."},
+			},
+			wantContext: "default",
+			wantStored:  true,
+		},
+		"context flag": {
+			config: &testConfig,
+			params: TestCmdParams{
+				serverResponses: map[string]ServerResponse{
+					youPath: {statusCode: http.StatusOK, responseFile: "login_active.json"},
+				},
+				args:     []string{"--context", "staging"},
+				expected: []string{"credentials stored in context staging."},
+			},
+			wantContext: "staging",
+			wantStored:  true,
+		},
+		"token requested": {
+			config: &testConfig,
+			params: TestCmdParams{
+				serverResponses: map[string]ServerResponse{
+					youPath: {statusCode: http.StatusOK, responseFile: "login_requested.json"},
+					tokenPath: {
+						statusCode:   http.StatusOK,
+						responseFile: "request_token.json",
+					},
+				},
+				expected: []string{
+					"The access token is not active yet, so the credentials were not stored.",
+					"Access token request status: requested (requested at 2022-08-01T12:00:00)",
+				},
+				wantError: true,
+			},
+			wantContext: "dev",
+		},
+		"invalid token": {
+			config: &testConfig,
+			params: TestCmdParams{
+				serverResponses: map[string]ServerResponse{
+					youPath: {
+						statusCode:   http.StatusForbidden,
+						responseFile: "login_invalid_token.json",
+					},
+				},
+				expected:  []string{"Token not valid."},
+				wantError: true,
+			},
+			wantContext: "dev",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			test.params.cmd = "login"
+			test.params.config = test.config
+			testCmdRun(t, test.params)
+
+			cfg, err := contexts.Load(os.Getenv(contexts.ConfigPathEnv))
+			if err != nil {
+				t.Fatal(err)
+			}
+			context := cfg.Contexts[test.wantContext]
+			stored := context.AccessToken == "1234" && strings.HasPrefix(
+				context.ServerURL,
+				"https://127.0.0.1:",
+			)
+			if stored != test.wantStored {
+				t.Errorf(
+					"Expected credentials stored: %t, instead got %v",
+					test.wantStored, context,
+				)
+			}
+		})
+	}
+}
+
+func TestPrompt(t *testing.T) {
+	tests := map[string]struct {
+		input        string
+		defaultValue string
+		expected     string
+		wantPrompt   string
+	}{
+		"value":         {input: "secret\n", expected: "secret", wantPrompt: "URL: "},
+		"default value": {input: "\n", defaultValue: "u", expected: "u", wantPrompt: "URL [u]: "},
+		"override":      {input: "b \n", defaultValue: "u", expected: "b", wantPrompt: "URL [u]: "},
+		"end of input":  {input: "", defaultValue: "u", expected: "u", wantPrompt: "URL [u]: "},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			out := new(bytes.Buffer)
+			in := bufio.NewReader(strings.NewReader(test.input))
+			value, err := prompt(in, out, "URL", test.defaultValue)
+			if err != nil {
+				t.Fatalf("Got unexpected error '%s'", err.Error())
+			}
+			if value != test.expected {
+				t.Errorf("Expected value '%s', instead got '%s'", test.expected, value)
+			}
+			if out.String() != test.wantPrompt {
+				t.Errorf("Expected prompt '%s', instead got '%s'", test.wantPrompt, out.String())
+			}
+		})
+	}
+}
+
+func TestPromptSecret(t *testing.T) {
+	// piped input is read line by line, since it is not a terminal
+	cmd := newLoginCmd()
+	out := new(bytes.Buffer)
+	cmd.SetOut(out)
+	cmd.SetIn(strings.NewReader("secret\n"))
+
+	value, err := promptSecret(cmd, bufio.NewReader(cmd.InOrStdin()), "Access token")
+	if err != nil {
+		t.Fatalf("Got unexpected error '%s'", err.Error())
+	}
+	if value != "secret" {
+		t.Errorf("Expected value 'secret', instead got '%s'", value)
+	}
+	if out.String() != "Access token: " {
+		t.Errorf("Expected prompt 'Access token: ', instead got '%s'", out.String())
+	}
+}
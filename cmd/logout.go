@@ -0,0 +1,104 @@
+/*
+This file is part of REANA.
+Copyright (C) 2022 CERN.
+
+REANA is free software; you can redistribute it and/or modify it
+under the terms of the MIT License; see LICENSE file for more details.
+*/
+
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"reanahub/reana-client-go/pkg/displayer"
+
+	"github.com/spf13/cobra"
+)
+
+const logoutDesc = `
+Log out from a REANA server.
+
+The ` + "``logout``" + ` command removes the access token stored in the context
+given by ` + "``--context``" + `, or in the current context, including from its
+credential store. The server URL is kept, so that it is proposed by the next
+` + "``login``" + `.
+
+Examples:
+
+$ reana-client logout
+
+$ reana-client logout --context prod
+`
+
+type logoutOptions struct {
+	context string
+}
+
+// newLogoutCmd creates a command to log out from a REANA server.
+func newLogoutCmd() *cobra.Command {
+	o := &logoutOptions{}
+
+	cmd := &cobra.Command{
+		Use:         "logout",
+		Short:       "Log out from a REANA server.",
+		Long:        logoutDesc,
+		Args:        cobra.NoArgs,
+		Annotations: map[string]string{contextsAnnotation: ""},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			context, err := selectedContext(cmd)
+			if err != nil {
+				return err
+			}
+			o.context = context
+			return o.run(cmd)
+		},
+	}
+
+	return cmd
+}
+
+func (o *logoutOptions) run(cmd *cobra.Command) error {
+	cfg, path, err := loadConfig()
+	if err != nil {
+		return err
+	}
+	context, found, err := cfg.Get(o.context)
+	if err != nil {
+		return err
+	}
+	if !found {
+		return errors.New(
+			"no context is selected, please provide one with the --context flag",
+		)
+	}
+	name := o.context
+	if name == "" {
+		name = cfg.CurrentContext
+	}
+
+	if context.AccessToken == "" && context.CredentialStore == "" {
+		displayer.DisplayMessage(
+			fmt.Sprintf("No credentials are stored in context %s.", name),
+			displayer.Info,
+			false,
+			cmd.OutOrStdout(),
+		)
+		return nil
+	}
+
+	if err := deleteToken(cmd, cfg, path, name); err != nil {
+		return err
+	}
+	if err := cfg.Save(path); err != nil {
+		return err
+	}
+
+	displayer.DisplayMessage(
+		fmt.Sprintf("Logged out, credentials removed from context %s.", name),
+		displayer.Success,
+		false,
+		cmd.OutOrStdout(),
+	)
+	return nil
+}
@@ -0,0 +1,79 @@
+/*
+This file is part of REANA.
+Copyright (C) 2022 CERN.
+
+REANA is free software; you can redistribute it and/or modify it
+under the terms of the MIT License; see LICENSE file for more details.
+*/
+
+package cmd
+
+import (
+	"reanahub/reana-client-go/pkg/contexts"
+	"strings"
+	"testing"
+)
+
+func TestLogout(t *testing.T) {
+	tests := map[string]struct {
+		config    *contexts.Config
+		args      []string
+		context   string
+		expected  string
+		wantError bool
+	}{
+		"current context": {
+			config:   &testConfig,
+			context:  "dev",
+			expected: "Logged out, credentials removed from context dev.",
+		},
+		"no credentials": {
+			config:   &testConfig,
+			args:     []string{"--context", "prod"},
+			context:  "prod",
+			expected: "No credentials are stored in context prod.",
+		},
+		"missing context": {
+			config:    &testConfig,
+			args:      []string{"--context", "staging"},
+			expected:  "context 'staging' does not exist",
+			wantError: true,
+		},
+		"no context selected": {
+			expected:  "no context is selected",
+			wantError: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			path := writeTestConfig(t, test.config)
+			args := append([]string{"logout"}, test.args...)
+			output, err := ExecuteCommand(NewRootCmd(), args...)
+			if test.wantError {
+				if err == nil || !strings.Contains(err.Error(), test.expected) {
+					t.Fatalf("Expected error '%s', instead got %v", test.expected, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Got unexpected error '%s'", err.Error())
+			}
+			if !strings.Contains(output, test.expected) {
+				t.Errorf("Expected '%s' in output, instead got '%s'", test.expected, output)
+			}
+
+			cfg, err := contexts.Load(path)
+			if err != nil {
+				t.Fatal(err)
+			}
+			context := cfg.Contexts[test.context]
+			if context.AccessToken != "" {
+				t.Errorf("Expected no access token, instead got %v", context)
+			}
+			if context.ServerURL != testConfig.Contexts[test.context].ServerURL {
+				t.Errorf("Expected server URL to be kept, instead got %v", context)
+			}
+		})
+	}
+}
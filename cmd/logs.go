@@ -9,14 +9,24 @@ under the terms of the MIT License; see LICENSE file for more details.
 package cmd
 
 import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"os"
+	"path/filepath"
 	"reanahub/reana-client-go/client"
 	"reanahub/reana-client-go/client/operations"
 	"reanahub/reana-client-go/pkg/config"
 	"reanahub/reana-client-go/pkg/displayer"
+	"reanahub/reana-client-go/pkg/errorhandler"
 	"reanahub/reana-client-go/pkg/filterer"
+	"reanahub/reana-client-go/pkg/workflows"
+	"sort"
 	"strings"
+	"time"
 
 	"github.com/jedib0t/go-pretty/v6/text"
 
@@ -31,11 +41,27 @@ The ` + "``logs``" + ` command allows to retrieve logs of running workflow. Note
 only finished steps of the workflow are returned, the logs of the currently
 processed step is not returned until it is finished.
 
+With ` + "``--follow``" + `, the logs are retrieved periodically until the workflow ends,
+and only the new logs and status changes of the jobs are displayed, prefixed by
+the name of their step. The command then exits with a code depending on the
+status of the workflow, as the ` + "``wait``" + ` command.
+
+With ` + "``--output-dir``" + `, the logs are saved in the given directory instead of being
+displayed: the workflow engine logs in workflow.log, the engine internal logs
+in engine_specific.log and the logs of each job in a file named after its step
+and job ID, starting with the metadata of the job. With ` + "``--tar``" + `, they are
+saved in a single gzip archive in this directory, e.g. to attach it to bug
+reports.
+
 Examples:
 
 $ reana-client logs -w myanalysis.42
 
 $ reana-client logs -w myanalysis.42 -s 1st_ste
+
+$ reana-client logs -w myanalysis.42 --follow
+
+$ reana-client logs -w myanalysis.42 --output-dir logs --tar
 `
 
 const logsFilterFlagDesc = `Filter job logs to include only those steps that
@@ -72,6 +98,15 @@ type logsOptions struct {
 	filters    []string
 	page       int64
 	size       int64
+	follow     bool
+	outputDir  string
+	tar        bool
+}
+
+// logFile a file in which the logs of a workflow are saved with --output-dir.
+type logFile struct {
+	name    string
+	content string
 }
 
 // newLogsCmd creates a command to get workflow logs.
@@ -101,11 +136,35 @@ func newLogsCmd() *cobra.Command {
 	f.StringSliceVar(&o.filters, "filter", []string{}, logsFilterFlagDesc)
 	f.Int64Var(&o.page, "page", 1, "Results page number (to be used with --size).")
 	f.Int64Var(&o.size, "size", 0, "Size of results per page (to be used with --page).")
+	f.BoolVar(
+		&o.follow,
+		"follow",
+		false,
+		"If set, follows the logs of the workflow until termination.",
+	)
+	f.StringVar(
+		&o.outputDir,
+		"output-dir",
+		"",
+		"Path to the directory where the logs are saved, in one file per job.",
+	)
+	f.BoolVar(
+		&o.tar,
+		"tar",
+		false,
+		"Save the logs in a gzip archive in the directory given by --output-dir.",
+	)
+	cmd.MarkFlagsMutuallyExclusive("follow", "json")
+	cmd.MarkFlagsMutuallyExclusive("output-dir", "json")
+	cmd.MarkFlagsMutuallyExclusive("output-dir", "follow")
 
 	return cmd
 }
 
 func (o *logsOptions) run(cmd *cobra.Command) error {
+	if o.tar && o.outputDir == "" {
+		return errors.New("--tar can only be used with --output-dir")
+	}
 	filters, err := parseLogsFilters(o.filters)
 	if err != nil {
 		return err
@@ -115,7 +174,7 @@ func (o *logsOptions) run(cmd *cobra.Command) error {
 		return err
 	}
 
-	logsParams := operations.NewGetWorkflowLogsParams()
+	logsParams := operations.NewGetWorkflowLogsParamsWithContext(cmd.Context())
 	logsParams.SetAccessToken(&o.token)
 	logsParams.SetWorkflowIDOrName(o.workflow)
 	logsParams.SetPage(&o.page)
@@ -128,22 +187,18 @@ func (o *logsOptions) run(cmd *cobra.Command) error {
 	if err != nil {
 		return err
 	}
-	logsResp, err := api.Operations.GetWorkflowLogs(logsParams)
-	if err != nil {
-		return err
+	if o.follow {
+		return o.followLogs(cmd, api, logsParams, filters)
 	}
 
-	var workflowLogs logs
-	err = json.Unmarshal([]byte(logsResp.GetPayload().Logs), &workflowLogs)
+	workflowLogs, err := getWorkflowLogs(api, logsParams, filters)
 	if err != nil {
 		return err
 	}
 
-	err = filterJobLogs(&workflowLogs.JobLogs, filters)
-	if err != nil {
-		return err
+	if o.outputDir != "" {
+		return o.saveLogs(cmd, workflowLogs)
 	}
-
 	if o.jsonOutput {
 		err := displayer.DisplayJsonOutput(workflowLogs, cmd.OutOrStdout())
 		if err != nil {
@@ -156,6 +211,215 @@ func (o *logsOptions) run(cmd *cobra.Command) error {
 	return nil
 }
 
+// saveLogs saves the logs of the workflow in the output directory, as separate files or as a gzip
+// archive, see buildLogFiles.
+func (o *logsOptions) saveLogs(cmd *cobra.Command, workflowLogs logs) error {
+	files := buildLogFiles(o.workflow, workflowLogs)
+	if err := os.MkdirAll(o.outputDir, 0755); err != nil {
+		return err
+	}
+
+	path := o.outputDir
+	if o.tar {
+		path = filepath.Join(o.outputDir, logFileName(o.workflow)+"_logs.tar.gz")
+		if err := writeLogsArchive(path, logFileName(o.workflow)+"_logs", files); err != nil {
+			return err
+		}
+	} else {
+		for _, file := range files {
+			content := []byte(file.content)
+			err := os.WriteFile(filepath.Join(o.outputDir, file.name), content, 0644)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	msg := fmt.Sprintf("Logs of %s saved in %s", o.workflow, path)
+	displayer.DisplayMessage(msg, displayer.Success, false, cmd.OutOrStdout())
+	return nil
+}
+
+// buildLogFiles builds the files in which the logs of a workflow are saved: workflow.log and
+// engine_specific.log, and one file per job named after its step and job ID, e.g.
+// fitdata_reana-run-job-1234.log. Each file starts with a header giving the metadata of its logs.
+func buildLogFiles(workflow string, workflowLogs logs) []logFile {
+	workflowHeader := fmt.Sprintf("# Workflow: %s\n", workflow)
+	files := []logFile{
+		{
+			name:    "workflow.log",
+			content: workflowHeader + logFileContent(workflowLogs.WorkflowLogs),
+		},
+		{
+			name:    "engine_specific.log",
+			content: workflowHeader + logFileContent(workflowLogs.EngineSpecific),
+		},
+	}
+
+	jobIds := make([]string, 0, len(workflowLogs.JobLogs))
+	for jobId := range workflowLogs.JobLogs {
+		jobIds = append(jobIds, jobId)
+	}
+	sort.Strings(jobIds)
+
+	names := map[string]bool{}
+	for _, jobId := range jobIds {
+		jobItem := workflowLogs.JobLogs[jobId]
+		stepName := jobId
+		if jobItem.JobName != "" {
+			stepName = jobItem.JobName
+		}
+		name := logFileName(stepName)
+		if jobItem.BackendJobId != "" {
+			name += "_" + logFileName(jobItem.BackendJobId)
+		}
+		// two jobs of the same step can lack a job ID, e.g. when they failed to be submitted
+		if names[name] {
+			name += "_" + logFileName(jobId)
+		}
+		names[name] = true
+
+		var header strings.Builder
+		header.WriteString(workflowHeader)
+		metadata := []struct {
+			title string
+			value *string
+		}{
+			{"Workflow ID", &jobItem.WorkflowUuid},
+			{"Step", &stepName},
+			{"Compute backend", &jobItem.ComputeBackend},
+			{"Job ID", &jobItem.BackendJobId},
+			{"Docker image", &jobItem.DockerImg},
+			{"Command", &jobItem.Cmd},
+			{"Status", &jobItem.Status},
+			{"Started", jobItem.StartedAt},
+			{"Finished", jobItem.FinishedAt},
+		}
+		for _, item := range metadata {
+			if item.value != nil && *item.value != "" {
+				fmt.Fprintf(&header, "# %s: %s\n", item.title, *item.value)
+			}
+		}
+		files = append(files, logFile{
+			name:    name + ".log",
+			content: header.String() + logFileContent(&jobItem.Logs),
+		})
+	}
+	return files
+}
+
+// logFileContent returns the logs to be saved after the header of a log file, separated by an
+// empty line, and ending with a line break.
+func logFileContent(logs *string) string {
+	if logs == nil || *logs == "" {
+		return ""
+	}
+	return "\n" + strings.TrimSuffix(*logs, "\n") + "\n"
+}
+
+// logFileName replaces the characters which are not safe in file names, e.g. the slashes of the
+// job IDs of some compute backends, by underscores.
+func logFileName(name string) string {
+	return strings.Map(func(r rune) rune {
+		if r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9' ||
+			strings.ContainsRune("._-", r) {
+			return r
+		}
+		return '_'
+	}, name)
+}
+
+// writeLogsArchive writes the log files to a gzip-compressed tar archive, in the directory dir.
+func writeLogsArchive(path, dir string, files []logFile) error {
+	var buf bytes.Buffer
+	gzipWriter := gzip.NewWriter(&buf)
+	tarWriter := tar.NewWriter(gzipWriter)
+	modTime := time.Now()
+	for _, file := range files {
+		header := &tar.Header{
+			Name:    dir + "/" + file.name,
+			Mode:    0644,
+			Size:    int64(len(file.content)),
+			ModTime: modTime,
+		}
+		if err := tarWriter.WriteHeader(header); err != nil {
+			return err
+		}
+		if _, err := tarWriter.Write([]byte(file.content)); err != nil {
+			return err
+		}
+	}
+	if err := tarWriter.Close(); err != nil {
+		return err
+	}
+	if err := gzipWriter.Close(); err != nil {
+		return err
+	}
+	return os.WriteFile(path, buf.Bytes(), 0644)
+}
+
+// followLogs displays the new logs of the workflow every config.CheckInterval seconds, until the
+// workflow ends. Returns an errorhandler.ExitError when it did not finish successfully.
+func (o *logsOptions) followLogs(
+	cmd *cobra.Command,
+	api *client.API,
+	logsParams *operations.GetWorkflowLogsParams,
+	filters filterer.Filters,
+) error {
+	var previous logs
+	for {
+		// the status is retrieved first, so that the logs are complete once the workflow has ended
+		statusPayload, err := workflows.GetStatus(cmd.Context(), o.token, o.workflow)
+		if err != nil {
+			return err
+		}
+		current, err := getWorkflowLogs(api, logsParams, filters)
+		if err != nil {
+			return err
+		}
+		displayNewLogs(cmd, previous, current)
+		previous = current
+
+		status := statusPayload.Status
+		if slices.Contains(config.EndedRunStatuses, status) {
+			if err := displayEndedStatus(cmd, o.workflow, status); err != nil {
+				return err
+			}
+			if exitCode := workflowExitCodes[status]; exitCode != 0 {
+				return &errorhandler.ExitError{Code: exitCode, Err: config.EmptyError}
+			}
+			return nil
+		}
+
+		select {
+		case <-cmd.Context().Done():
+			return cmd.Context().Err()
+		case <-time.After(time.Duration(config.CheckInterval) * time.Second):
+		}
+	}
+}
+
+// getWorkflowLogs retrieves the logs of a workflow, keeping the job logs matching the filters.
+func getWorkflowLogs(
+	api *client.API,
+	logsParams *operations.GetWorkflowLogsParams,
+	filters filterer.Filters,
+) (logs, error) {
+	var workflowLogs logs
+	logsResp, err := api.Operations.GetWorkflowLogs(logsParams)
+	if err != nil {
+		return workflowLogs, err
+	}
+
+	err = json.Unmarshal([]byte(logsResp.GetPayload().Logs), &workflowLogs)
+	if err != nil {
+		return workflowLogs, err
+	}
+
+	err = filterJobLogs(&workflowLogs.JobLogs, filters)
+	return workflowLogs, err
+}
+
 // parseLogsFilters parses a list of filters in the format 'filter=value', for the 'logs' command.
 // Returns an error if any of the given filters are not valid.
 func parseLogsFilters(filterInput []string) (filterer.Filters, error) {
@@ -282,6 +546,74 @@ func displayHumanFriendlyLogs(cmd *cobra.Command, logs logs, steps []string) {
 	}
 }
 
+// displayNewLogs displays the workflow engine logs and the job logs which changed between the
+// previous and the current logs of a workflow. Each line is prefixed by the name of its step, and
+// only the new lines are displayed when the previous logs of a job are part of the current ones.
+func displayNewLogs(cmd *cobra.Command, previous, current logs) {
+	if current.WorkflowLogs != nil {
+		previousLogs := ""
+		if previous.WorkflowLogs != nil {
+			previousLogs = *previous.WorkflowLogs
+		}
+		displayPrefixedLines(cmd, "workflow", newLogLines(previousLogs, *current.WorkflowLogs))
+	}
+
+	jobIds := make([]string, 0, len(current.JobLogs))
+	for jobId := range current.JobLogs {
+		jobIds = append(jobIds, jobId)
+	}
+	// the jobs are displayed in the order they started, the ones not started yet being first
+	sort.Slice(jobIds, func(i, j int) bool {
+		startedI := current.JobLogs[jobIds[i]].StartedAt
+		startedJ := current.JobLogs[jobIds[j]].StartedAt
+		if (startedI == nil) != (startedJ == nil) {
+			return startedI == nil
+		}
+		if startedI != nil && *startedI != *startedJ {
+			return *startedI < *startedJ
+		}
+		return jobIds[i] < jobIds[j]
+	})
+
+	for _, jobId := range jobIds {
+		jobItem := current.JobLogs[jobId]
+		previousItem, found := previous.JobLogs[jobId]
+		stepName := jobId
+		if jobItem.JobName != "" {
+			stepName = jobItem.JobName
+		}
+
+		if !found || previousItem.Status != jobItem.Status {
+			statusLine := fmt.Sprintf("Status: %s", jobItem.Status)
+			displayPrefixedLines(cmd, stepName, []string{statusLine})
+		}
+		displayPrefixedLines(cmd, stepName, newLogLines(previousItem.Logs, jobItem.Logs))
+	}
+}
+
+// newLogLines returns the lines of the current logs which are not part of the previous ones.
+// All of them are returned when the previous logs are not a prefix of the current ones.
+func newLogLines(previous, current string) []string {
+	if current == previous {
+		return nil
+	}
+	if strings.HasPrefix(current, previous) {
+		current = current[len(previous):]
+	}
+	current = strings.TrimSuffix(strings.TrimPrefix(current, "\n"), "\n")
+	if current == "" {
+		return nil
+	}
+	return strings.Split(current, "\n")
+}
+
+// displayPrefixedLines displays the lines prefixed by the given name, so that they can be grepped.
+func displayPrefixedLines(cmd *cobra.Command, name string, lines []string) {
+	for _, line := range lines {
+		cmd.Printf("[%s] %s\n", name, line)
+	}
+}
+
 // displayLogItem displays an optional log item if it is not nil or an empty string.
 // The title is displayed according to the color associated with the job's status.
 func displayLogItem(cmd *cobra.Command, item *string, title, status string) {
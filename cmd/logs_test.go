@@ -9,13 +9,21 @@ under the terms of the MIT License; see LICENSE file for more details.
 package cmd
 
 import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
 	"fmt"
+	"io"
 	"net/http"
+	"os"
+	"path/filepath"
 	"reanahub/reana-client-go/pkg/config"
 	"reanahub/reana-client-go/pkg/filterer"
 	"reflect"
+	"strings"
 	"testing"
 
+	"github.com/spf13/cobra"
 	"golang.org/x/exp/slices"
 )
 
@@ -140,6 +148,51 @@ func TestLogs(t *testing.T) {
 			expected:  []string{"Field 'page': Must be at least 1."},
 			wantError: true,
 		},
+		"follow": {
+			serverResponses: map[string]ServerResponse{
+				fmt.Sprintf(logsPathTemplate, workflowName): {
+					statusCode:   http.StatusOK,
+					responseFile: "logs_complete.json",
+				},
+				fmt.Sprintf(waitStatusPathTemplate, workflowName): {
+					statusCode:   http.StatusOK,
+					responseFile: "status_finished.json",
+				},
+			},
+			args: []string{"-w", workflowName, "--follow"},
+			expected: []string{
+				"[workflow] workflow logs",
+				"[job1] Status: finished", "[job1] workflow 1 logs",
+				"[job2] Status: running", "[job2] workflow 2 logs",
+				workflowName + " has finished",
+			},
+			unwanted: []string{"Workflow engine logs", "engine logs"},
+		},
+		"follow stopped workflow": {
+			serverResponses: map[string]ServerResponse{
+				fmt.Sprintf(logsPathTemplate, workflowName): {
+					statusCode:   http.StatusOK,
+					responseFile: "logs_complete.json",
+				},
+				fmt.Sprintf(waitStatusPathTemplate, workflowName): {
+					statusCode:   http.StatusOK,
+					responseFile: "status_stopped.json",
+				},
+			},
+			args:      []string{"-w", workflowName, "--follow"},
+			expected:  []string{"[job1] workflow 1 logs", workflowName + " has been stopped"},
+			wantError: true,
+		},
+		"follow with json": {
+			args:      []string{"-w", workflowName, "--follow", "--json"},
+			expected:  []string{"[follow json] were all set"},
+			wantError: true,
+		},
+		"tar without output dir": {
+			args:      []string{"-w", workflowName, "--tar"},
+			expected:  []string{"--tar can only be used with --output-dir"},
+			wantError: true,
+		},
 	}
 
 	for name, params := range tests {
@@ -150,6 +203,136 @@ func TestLogs(t *testing.T) {
 	}
 }
 
+func TestLogsOutputDir(t *testing.T) {
+	workflowName := "my_workflow"
+	expectedFiles := map[string][]string{
+		"workflow.log":        {"# Workflow: my_workflow\n\nworkflow logs\n"},
+		"engine_specific.log": {"# Workflow: my_workflow\n\nengine logs\n"},
+		"job1_backend1.log": {
+			"# Workflow: my_workflow\n# Workflow ID: workflow_1\n# Step: job1\n",
+			"# Compute backend: Kubernetes\n# Job ID: backend1\n# Docker image: docker1\n",
+			"# Command: ls\n# Status: finished\n# Started: 2022-07-20T12:09:09\n",
+			"# Finished: 2022-07-20T19:09:09\n\nworkflow 1 logs\n",
+		},
+		"job2_backend2.log": {"# Step: job2\n", "# Compute backend: Slurm\n", "workflow 2 logs"},
+	}
+
+	tests := map[string]struct {
+		tar bool
+	}{
+		"files":   {tar: false},
+		"archive": {tar: true},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			outputDir := filepath.Join(t.TempDir(), "logs")
+			args := []string{"-w", workflowName, "--output-dir", outputDir}
+			savedPath := outputDir
+			if test.tar {
+				args = append(args, "--tar")
+				savedPath = filepath.Join(outputDir, "my_workflow_logs.tar.gz")
+			}
+			testCmdRun(t, TestCmdParams{
+				cmd: "logs",
+				serverResponses: map[string]ServerResponse{
+					fmt.Sprintf(logsPathTemplate, workflowName): {
+						statusCode:   http.StatusOK,
+						responseFile: "logs_complete.json",
+					},
+				},
+				args:     args,
+				expected: []string{"Logs of my_workflow saved in " + savedPath},
+			})
+
+			files := map[string]string{}
+			if test.tar {
+				files = readLogsArchive(t, savedPath, "my_workflow_logs")
+			} else {
+				entries, err := os.ReadDir(outputDir)
+				if err != nil {
+					t.Fatalf("Unexpected error: %s", err)
+				}
+				for _, entry := range entries {
+					content, err := os.ReadFile(filepath.Join(outputDir, entry.Name()))
+					if err != nil {
+						t.Fatalf("Unexpected error: %s", err)
+					}
+					files[entry.Name()] = string(content)
+				}
+			}
+
+			if len(files) != len(expectedFiles) {
+				t.Errorf("Expected %d files, got %d: %v", len(expectedFiles), len(files), files)
+			}
+			for fileName, expectedParts := range expectedFiles {
+				content, found := files[fileName]
+				if !found {
+					t.Errorf("Expected file %s to be saved", fileName)
+				}
+				for _, part := range expectedParts {
+					if !strings.Contains(content, part) {
+						t.Errorf("Expected '%s' in %s, got '%s'", part, fileName, content)
+					}
+				}
+			}
+		})
+	}
+}
+
+// readLogsArchive reads the files of a gzip-compressed tar archive, which are expected to be in
+// the directory dir, and returns their content by file name.
+func readLogsArchive(t *testing.T, path, dir string) map[string]string {
+	archive, err := os.Open(path)
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	defer archive.Close()
+	gzipReader, err := gzip.NewReader(archive)
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	tarReader := tar.NewReader(gzipReader)
+
+	files := map[string]string{}
+	for {
+		header, err := tarReader.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		if filepath.Dir(header.Name) != dir {
+			t.Errorf("Expected %s to be in the directory %s", header.Name, dir)
+		}
+		content, err := io.ReadAll(tarReader)
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		files[filepath.Base(header.Name)] = string(content)
+	}
+	return files
+}
+
+func TestLogFileName(t *testing.T) {
+	tests := map[string]string{
+		"fitdata":                 "fitdata",
+		"reana-run-job-1234.5":    "reana-run-job-1234.5",
+		"htcondor/cluster 42":     "htcondor_cluster_42",
+		"../../etc/passwd":        ".._.._etc_passwd",
+		"step_with_\u00e9_accent": "step_with___accent",
+	}
+
+	for name, expected := range tests {
+		t.Run(name, func(t *testing.T) {
+			if result := logFileName(name); result != expected {
+				t.Errorf("Expected '%s', got '%s'", expected, result)
+			}
+		})
+	}
+}
+
 func TestParseLogsFilters(t *testing.T) {
 	tests := map[string]struct {
 		filterInput []string
@@ -281,3 +464,89 @@ func TestFilterJobLogs(t *testing.T) {
 		})
 	}
 }
+
+func TestDisplayNewLogs(t *testing.T) {
+	workflowLogs := "engine started"
+	newWorkflowLogs := "engine started\nstep1 submitted"
+	started := "2022-07-20T12:09:09"
+	previous := logs{
+		WorkflowLogs: &workflowLogs,
+		JobLogs: map[string]jobLogItem{
+			"1": {JobName: "step1", Status: "running", Logs: "line 1\n", StartedAt: &started},
+			"2": {JobName: "step2", Status: "running", Logs: "same", StartedAt: &started},
+		},
+	}
+	current := logs{
+		WorkflowLogs: &newWorkflowLogs,
+		JobLogs: map[string]jobLogItem{
+			"1": {JobName: "step1", Status: "finished", Logs: "line 1\nline 2\n"},
+			"2": {JobName: "step2", Status: "running", Logs: "same", StartedAt: &started},
+			"3": {Status: "created"},
+		},
+	}
+
+	tests := map[string]struct {
+		previous logs
+		expected []string
+		unwanted []string
+	}{
+		"first poll": {
+			expected: []string{
+				"[workflow] engine started", "[workflow] step1 submitted",
+				"[step1] Status: finished", "[step1] line 1", "[step1] line 2",
+				"[step2] Status: running", "[step2] same", "[3] Status: created",
+			},
+		},
+		"changed logs": {
+			previous: previous,
+			expected: []string{
+				"[workflow] step1 submitted", "[step1] Status: finished", "[step1] line 2",
+				"[3] Status: created",
+			},
+			unwanted: []string{"engine started", "line 1", "step2"},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			buf := new(bytes.Buffer)
+			cmd := &cobra.Command{}
+			cmd.SetOut(buf)
+
+			displayNewLogs(cmd, test.previous, current)
+			output := buf.String()
+			for _, expected := range test.expected {
+				if !strings.Contains(output, expected) {
+					t.Errorf("Expected '%s' in output, got '%s'", expected, output)
+				}
+			}
+			for _, unwanted := range test.unwanted {
+				if strings.Contains(output, unwanted) {
+					t.Errorf("Expected '%s' not to be in output, got '%s'", unwanted, output)
+				}
+			}
+		})
+	}
+}
+
+func TestNewLogLines(t *testing.T) {
+	tests := map[string]struct {
+		previous, current string
+		expected          []string
+	}{
+		"no logs":       {},
+		"unchanged":     {previous: "a\nb", current: "a\nb"},
+		"new logs":      {current: "a\nb\n", expected: []string{"a", "b"}},
+		"appended logs": {previous: "a\n", current: "a\nb\nc", expected: []string{"b", "c"}},
+		"replaced logs": {previous: "a\nb", current: "c", expected: []string{"c"}},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			lines := newLogLines(test.previous, test.current)
+			if !slices.Equal(lines, test.expected) {
+				t.Errorf("Expected %q, got %q", test.expected, lines)
+			}
+		})
+	}
+}
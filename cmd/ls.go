@@ -17,6 +17,7 @@ import (
 	"reanahub/reana-client-go/pkg/displayer"
 	"reanahub/reana-client-go/pkg/filterer"
 	"reanahub/reana-client-go/pkg/formatter"
+	"strings"
 
 	"github.com/go-gota/gota/dataframe"
 	"github.com/go-gota/gota/series"
@@ -54,7 +55,9 @@ E.g.display files named data.txt
 const lsFilterFlagDesc = `Filter results to show only files that match certain filtering criteria such as
 file name, size or modification date.
 Use --filter <column_name>=<column_value> pairs. Available
-filters are 'name', 'size' and 'last-modified'.`
+filters are 'name', 'size' and 'last-modified'.
+The operators !=, >, >=, <, <=, ~ (regex) and !~, and glob patterns, are applied
+client-side, e.g. --filter size>10MB or --filter last-modified>-7d.`
 
 type lsOptions struct {
 	token         string
@@ -62,6 +65,7 @@ type lsOptions struct {
 	workflow      string
 	formatFilters []string
 	jsonOutput    bool
+	output        string
 	displayURLs   bool
 	humanReadable bool
 	filters       []string
@@ -99,6 +103,7 @@ func newLsCmd() *cobra.Command {
 	)
 	f.StringSliceVar(&o.formatFilters, "format", []string{}, lsFormatFlagDesc)
 	f.BoolVar(&o.jsonOutput, "json", false, "Get output in JSON format.")
+	addOutputFlag(f, &o.output)
 	f.BoolVar(&o.displayURLs, "url", false, "Get URLs of output files.")
 	f.BoolVarP(
 		&o.humanReadable,
@@ -117,9 +122,14 @@ func newLsCmd() *cobra.Command {
 }
 
 func (o *lsOptions) run(cmd *cobra.Command) error {
+	format, err := outputFormat(cmd.Flags(), o.output, o.jsonOutput)
+	if err != nil {
+		return err
+	}
+
 	header := []string{"name", "size", "last-modified"}
 
-	filters, err := filterer.NewFilters(nil, header, o.filters)
+	filters, err := filterer.NewClientFilters(nil, header, header, o.filters)
 	if err != nil {
 		return err
 	}
@@ -130,7 +140,7 @@ func (o *lsOptions) run(cmd *cobra.Command) error {
 
 	log.Infof("Workflow %s selected", o.workflow)
 
-	lsParams := operations.NewGetFilesParams()
+	lsParams := operations.NewGetFilesParamsWithContext(cmd.Context())
 	lsParams.SetAccessToken(&o.token)
 	lsParams.SetWorkflowIDOrName(o.workflow)
 	lsParams.SetFileName(&o.fileName)
@@ -157,8 +167,9 @@ func (o *lsOptions) run(cmd *cobra.Command) error {
 			cmd,
 			lsResp.Payload,
 			header,
+			filters.GetClientFilters(),
 			parsedFormatFilters,
-			o.jsonOutput,
+			format,
 			o.humanReadable,
 		)
 		if err != nil {
@@ -173,8 +184,9 @@ func displayLsFiles(
 	cmd *cobra.Command,
 	p *operations.GetFilesOKBody,
 	header []string,
+	clientFilters []filterer.Expression,
 	formatFilters []formatter.FormatFilter,
-	jsonOutput bool,
+	format displayer.OutputFormat,
 	humanReadable bool,
 ) error {
 	var df dataframe.DataFrame
@@ -205,22 +217,16 @@ func displayLsFiles(
 		df = df.CBind(dataframe.New(colSeries))
 	}
 
-	df, err := formatter.FormatDataFrame(df, formatFilters)
+	df, err := filterer.FilterDataFrame(df, clientFilters)
 	if err != nil {
 		return err
 	}
-
-	if jsonOutput {
-		err := displayer.DisplayJsonOutput(df.Maps(), cmd.OutOrStdout())
-		if err != nil {
-			return err
-		}
-	} else {
-		data := formatter.DataFrameToStringData(df)
-		displayer.DisplayTable(df.Names(), data, cmd.OutOrStdout())
+	df, err = formatter.FormatDataFrame(df, formatFilters)
+	if err != nil {
+		return err
 	}
 
-	return nil
+	return displayer.DisplayPayloadOutput(df, p, format, cmd.OutOrStdout())
 }
 
 func buildLsSeries(col string, humanReadable bool) series.Series {
@@ -237,7 +243,10 @@ func displayLsURLs(
 	workflow string,
 ) {
 	for _, file := range p.Items {
-		fileURL := fmt.Sprintf("%s/api/workflows/%s/workspace/%s", serverURL, workflow, file.Name)
+		fileURL := fmt.Sprintf(
+			"%s/api/workflows/%s/workspace/%s",
+			strings.TrimSuffix(serverURL, "/"), workflow, file.Name,
+		)
 		cmd.Println(fileURL)
 	}
 }
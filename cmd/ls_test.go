@@ -99,6 +99,36 @@ func TestLs(t *testing.T) {
 				"results/data.root", "154455", "2022-07-11T13:30:17",
 			},
 		},
+		"client filters": {
+			serverResponses: map[string]ServerResponse{
+				fmt.Sprintf(lsPathTemplate, workflowName): {
+					statusCode:   http.StatusOK,
+					responseFile: "ls_complete.json",
+				},
+			},
+			args: []string{"-w", workflowName, "--filter", "size>100KB", "-h"},
+			expected: []string{
+				"results/data.root", "150.83 KiB",
+			},
+			unwanted: []string{
+				"code/gendata.C", "1.89 KiB",
+			},
+		},
+		"format with operators": {
+			serverResponses: map[string]ServerResponse{
+				fmt.Sprintf(lsPathTemplate, workflowName): {
+					statusCode:   http.StatusOK,
+					responseFile: "ls_complete.json",
+				},
+			},
+			args: []string{"-w", workflowName, "--format", "name,last-modified<2022-07-11T13:00"},
+			expected: []string{
+				"code/gendata.C", "2022-07-11T12:50:33",
+			},
+			unwanted: []string{
+				"results/data.root", "2022-07-11T13:30:17",
+			},
+		},
 		"invalid format column": {
 			serverResponses: map[string]ServerResponse{
 				fmt.Sprintf(lsPathTemplate, workflowName): {
@@ -133,6 +163,30 @@ func TestLs(t *testing.T) {
   }
 ]`},
 		},
+		"markdown": {
+			serverResponses: map[string]ServerResponse{
+				fmt.Sprintf(lsPathTemplate, workflowName): {
+					statusCode:   http.StatusOK,
+					responseFile: "ls_complete.json",
+				},
+			},
+			args: []string{"-w", workflowName, "-o", "markdown"},
+			expected: []string{`| name | size | last-modified |
+| --- | --- | --- |
+| code/gendata.C | 1937 | 2022-07-11T12:50:33 |
+| results/data.root | 154455 | 2022-07-11T13:30:17 |
+`},
+		},
+		"json and output": {
+			serverResponses: map[string]ServerResponse{
+				fmt.Sprintf(lsPathTemplate, workflowName): {
+					statusCode:   http.StatusOK,
+					responseFile: "ls_complete.json",
+				},
+			},
+			args:     []string{"-w", workflowName, "--json", "-o", "csv"},
+			expected: []string{"name,size,last-modified\ncode/gendata.C,1937,"},
+		},
 		"display URLs": {
 			serverResponses: map[string]ServerResponse{
 				fmt.Sprintf(lsPathTemplate, workflowName): {
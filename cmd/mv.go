@@ -63,7 +63,7 @@ func newMvCmd() *cobra.Command {
 }
 
 func (o *mvOptions) run(cmd *cobra.Command) error {
-	mvParams := operations.NewMoveFilesParams()
+	mvParams := operations.NewMoveFilesParamsWithContext(cmd.Context())
 	mvParams.SetAccessToken(&o.token)
 	mvParams.SetWorkflowIDOrName(o.workflow)
 	mvParams.SetSource(o.source)
@@ -87,7 +87,7 @@ func newOpenCmd() *cobra.Command {
 }
 
 func (o *openOptions) run(cmd *cobra.Command) error {
-	openParams := operations.NewOpenInteractiveSessionParams()
+	openParams := operations.NewOpenInteractiveSessionParamsWithContext(cmd.Context())
 	openParams.SetAccessToken(&o.token)
 	openParams.SetWorkflowIDOrName(o.workflow)
 	openParams.SetInteractiveSessionType(o.interactiveSessionType)
@@ -111,8 +111,14 @@ func (o *openOptions) run(cmd *cobra.Command) error {
 		false,
 		cmd.OutOrStdout(),
 	)
-	sessionURI := formatter.FormatSessionURI(o.serverURL, openResp.Payload.Path, o.token)
+	showToken := viper.GetBool("show-token")
+	sessionURI := formatter.FormatSessionURI(
+		o.serverURL, openResp.Payload.Path, o.token, showToken,
+	)
 	displayer.PrintColorable(sessionURI+"\n", cmd.OutOrStdout(), text.FgGreen)
+	if !showToken {
+		cmd.Println("The access token is redacted, use --show-token to display it.")
+	}
 	cmd.Println("It could take several minutes to start the interactive session.")
 	return nil
 }
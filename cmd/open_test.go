@@ -31,9 +31,11 @@ func TestOpen(t *testing.T) {
 			args: []string{"-w", workflowName},
 			expected: []string{
 				"Interactive session opened successfully",
-				"/test/jupyter?token=1234",
+				"/test/jupyter?token=REDACTED",
+				"The access token is redacted, use --show-token to display it.",
 				"It could take several minutes to start the interactive session.",
 			},
+			unwanted: []string{"token=1234"},
 		},
 		"success extra args": {
 			serverResponses: map[string]ServerResponse{
@@ -42,7 +44,7 @@ func TestOpen(t *testing.T) {
 					responseFile: "open_jupyter.json",
 				},
 			},
-			args: []string{"-w", workflowName, "-i", "image", "jupyter"},
+			args: []string{"-w", workflowName, "-i", "image", "--show-token", "jupyter"},
 			expected: []string{
 				"Interactive session opened successfully",
 				"/test/jupyter?token=1234",
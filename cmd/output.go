@@ -0,0 +1,51 @@
+/*
+This file is part of REANA.
+Copyright (C) 2022 CERN.
+
+REANA is free software; you can redistribute it and/or modify it
+under the terms of the MIT License; see LICENSE file for more details.
+*/
+
+package cmd
+
+import (
+	"reanahub/reana-client-go/pkg/displayer"
+	"reanahub/reana-client-go/pkg/validator"
+	"strings"
+
+	"github.com/spf13/pflag"
+)
+
+var outputFlagDesc = `Output format [` + strings.Join(displayer.OutputFormats, "|") +
+	`|go-template=TEMPLATE|jsonpath=TEMPLATE].
+The wide format is a table including the verbose information. The templates are
+applied to the raw response of the server, as in kubectl, e.g.
+-o go-template='{{range .items}}{{.name}}{{"\n"}}{{end}}' or
+-o jsonpath='{.items[*].status}'.`
+
+// addOutputFlag adds the --output flag, shared by the commands displaying tables.
+func addOutputFlag(f *pflag.FlagSet, output *string) {
+	f.StringVarP(output, "output", "o", string(displayer.TableFormat), outputFlagDesc)
+}
+
+// outputFormat validates the format, or the template, given to --output. The --json flag of the
+// commands supporting it is a shortcut for --output json.
+func outputFormat(
+	f *pflag.FlagSet,
+	output string,
+	jsonOutput bool,
+) (displayer.OutputFormat, error) {
+	if jsonOutput && !f.Changed("output") {
+		return displayer.JSONFormat, nil
+	}
+	format := displayer.OutputFormat(output)
+	if format.IsTemplate() {
+		// the template is parsed to report its errors before sending any request
+		_, err := displayer.NewOutputter(format)
+		return format, err
+	}
+	if err := validator.ValidateChoice(output, displayer.OutputFormats, "output"); err != nil {
+		return "", err
+	}
+	return format, nil
+}
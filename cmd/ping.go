@@ -51,7 +51,7 @@ func newPingCmd() *cobra.Command {
 }
 
 func (o *pingOptions) run(cmd *cobra.Command) error {
-	pingParams := operations.NewGetYouParams()
+	pingParams := operations.NewGetYouParamsWithContext(cmd.Context())
 	pingParams.SetAccessToken(&o.token)
 
 	api, err := client.ApiClient()
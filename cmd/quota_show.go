@@ -107,7 +107,7 @@ func newQuotaShowCmd() *cobra.Command {
 }
 
 func (o *quotaShowOptions) run(cmd *cobra.Command) error {
-	quotaParams := operations.NewGetYouParams()
+	quotaParams := operations.NewGetYouParamsWithContext(cmd.Context())
 	quotaParams.SetAccessToken(&o.token)
 
 	api, err := client.ApiClient()
@@ -0,0 +1,102 @@
+/*
+This file is part of REANA.
+Copyright (C) 2022 CERN.
+
+REANA is free software; you can redistribute it and/or modify it
+under the terms of the MIT License; see LICENSE file for more details.
+*/
+
+package cmd
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestRestart(t *testing.T) {
+	workflowName := "my_workflow.1"
+	restartedID := "my_restarted_workflow_id"
+	restartResponses := map[string]ServerResponse{
+		fmt.Sprintf(startPathTemplate, workflowName): {
+			statusCode:   http.StatusOK,
+			responseFile: "restart_success.json",
+		},
+		fmt.Sprintf(statusPathTemplate, restartedID): {
+			statusCode:   http.StatusOK,
+			responseFile: "restart_status_queued.json",
+		},
+	}
+
+	tests := map[string]TestCmdParams{
+		"default": {
+			serverResponses: restartResponses,
+			args:            []string{"-w", workflowName},
+			expected:        []string{"my_workflow.2 has been queued"},
+		},
+		"with specification file": {
+			serverResponses: restartResponses,
+			args: []string{
+				"-w", workflowName, "-f", "../testdata/specs/reana.yaml",
+				"-p", "sleeptime=2,invalid=3", "-o", "FROM=step1",
+			},
+			expected: []string{
+				"given parameter - invalid, is not in reana.yaml",
+				"my_workflow.2 has been queued",
+			},
+		},
+		"with server parameters": {
+			serverResponses: map[string]ServerResponse{
+				fmt.Sprintf(startPathTemplate, workflowName): {
+					statusCode:   http.StatusOK,
+					responseFile: "restart_success.json",
+				},
+				fmt.Sprintf(statusPathTemplate, restartedID): {
+					statusCode:   http.StatusOK,
+					responseFile: "restart_status_queued.json",
+				},
+				fmt.Sprintf(paramsPathTemplate, workflowName): {
+					statusCode:   http.StatusOK,
+					responseFile: "start_params_multiple.json",
+				},
+			},
+			args:     []string{"-w", workflowName, "-p", "events=100", "-o", "FROM=gendata"},
+			expected: []string{"my_workflow.2 has been queued"},
+		},
+		"unsupported option": {
+			serverResponses: restartResponses,
+			args: []string{
+				"-w", workflowName, "-f", "../testdata/specs/reana.yaml", "-o", "INVALID=1",
+			},
+			expected:  []string{"operational option 'INVALID' not supported"},
+			wantError: true,
+		},
+		"failed restart": {
+			serverResponses: map[string]ServerResponse{
+				fmt.Sprintf(startPathTemplate, workflowName): {
+					statusCode:   http.StatusOK,
+					responseFile: "restart_success.json",
+				},
+				fmt.Sprintf(statusPathTemplate, restartedID): {
+					statusCode:   http.StatusOK,
+					responseFile: "restart_status_failed.json",
+				},
+			},
+			args:      []string{"-w", workflowName},
+			expected:  []string{"my_workflow.2 has failed"},
+			wantError: true,
+		},
+		"invalid specification file": {
+			args:      []string{"-w", workflowName, "-f", "../testdata/specs/missing.yaml"},
+			expected:  []string{"cannot read specification file"},
+			wantError: true,
+		},
+	}
+
+	for name, params := range tests {
+		t.Run(name, func(t *testing.T) {
+			params.cmd = "restart"
+			testCmdRun(t, params)
+		})
+	}
+}
@@ -72,7 +72,7 @@ func (o *rmOptions) run(cmd *cobra.Command) error {
 
 	hasError := false
 	for _, fileName := range o.fileNames {
-		rmParams := operations.NewDeleteFileParams()
+		rmParams := operations.NewDeleteFileParamsWithContext(cmd.Context())
 		rmParams.SetAccessToken(&o.token)
 		rmParams.SetWorkflowIDOrName(o.workflow)
 		rmParams.SetFileName(fileName)
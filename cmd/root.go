@@ -10,8 +10,15 @@ under the terms of the MIT License; see LICENSE file for more details.
 package cmd
 
 import (
+	"errors"
 	"os"
+	"reanahub/reana-client-go/client"
+	"reanahub/reana-client-go/pkg/contexts"
+	"reanahub/reana-client-go/pkg/credentials"
+	"reanahub/reana-client-go/pkg/displayer"
+	"reanahub/reana-client-go/pkg/formatter"
 	"reanahub/reana-client-go/pkg/validator"
+	"time"
 
 	"github.com/spf13/pflag"
 
@@ -22,7 +29,17 @@ import (
 )
 
 type rootOptions struct {
-	logLevel string
+	logLevel        string
+	context         string
+	caCert          string
+	clientCert      string
+	clientKey       string
+	insecure        bool
+	maxRetries      int
+	timeout         time.Duration
+	proxy           string
+	credentialStore string
+	showToken       bool
 }
 
 // NewRootCmd creates a new root command, responsible for creating all the other subcommands and
@@ -44,17 +61,93 @@ func NewRootCmd() *cobra.Command {
 
 	cmd.PersistentFlags().
 		StringVarP(&o.logLevel, "loglevel", "l", "WARNING", "Sets log level [DEBUG|INFO|WARNING]")
+	cmd.PersistentFlags().StringVar(
+		&o.context,
+		"context",
+		"",
+		`Name of the context of the configuration file to use.
+Overrides value of REANA_CONTEXT environment variable and the current context.`,
+	)
+	cmd.PersistentFlags().StringVar(
+		&o.caCert,
+		"ca-cert",
+		"",
+		`CA bundle used to verify the certificate of the REANA server.
+Overrides value of REANA_CA_BUNDLE environment variable.`,
+	)
+	cmd.PersistentFlags().StringVar(
+		&o.clientCert,
+		"client-cert",
+		"",
+		`Client certificate used to authenticate to the REANA server with mutual TLS.
+Overrides value of REANA_CLIENT_CERT environment variable.`,
+	)
+	cmd.PersistentFlags().StringVar(
+		&o.clientKey,
+		"client-key",
+		"",
+		`Private key of the client certificate.
+Overrides value of REANA_CLIENT_KEY environment variable.`,
+	)
+	cmd.PersistentFlags().BoolVar(
+		&o.insecure,
+		"insecure",
+		false,
+		"Skip the verification of the certificate of the REANA server. Not recommended.",
+	)
+	cmd.PersistentFlags().IntVar(
+		&o.maxRetries,
+		"max-retries",
+		client.DefaultMaxRetries,
+		`Maximum number of retries of read-only requests failing because of connection or server errors.
+Overrides value of REANA_MAX_RETRIES environment variable.`,
+	)
+	cmd.PersistentFlags().DurationVar(
+		&o.timeout,
+		"timeout",
+		client.DefaultTimeout,
+		`Timeout waiting for the response of each request to the REANA server, once the
+request is sent (e.g. 30s, 5m), 0 disables it. Uploading and downloading the
+content of files is not limited by the timeout.
+Overrides value of REANA_TIMEOUT environment variable.`,
+	)
+	cmd.PersistentFlags().StringVar(
+		&o.proxy,
+		"proxy",
+		"",
+		`URL of the proxy used for all the requests to the REANA server.
+Overrides HTTPS_PROXY, HTTP_PROXY and NO_PROXY environment variables.`,
+	)
+	cmd.PersistentFlags().StringVar(
+		&o.credentialStore,
+		"credential-store",
+		"",
+		`Store the access token given to login outside of the configuration file, either
+in the keyring (Linux only, requires the secret-tool command of libsecret) or
+in a file encrypted with a passphrase, asked interactively or taken from
+REANA_CREDENTIALS_PASSPHRASE [keyring|file].
+Overrides value of REANA_CREDENTIAL_STORE environment variable.`,
+	)
+	cmd.PersistentFlags().BoolVar(
+		&o.showToken,
+		"show-token",
+		false,
+		`Display the access tokens in session URIs, debug logs and config view instead of
+redacting them.`,
+	)
 
 	// Add commands
 	cmd.AddCommand(newVersionCmd())
 	cmd.AddCommand(newPingCmd())
 	cmd.AddCommand(newInfoCmd())
+	cmd.AddCommand(newWhoamiCmd())
 	cmd.AddCommand(newListCmd())
 	cmd.AddCommand(newDuCmd())
 	cmd.AddCommand(newOpenCmd())
 	cmd.AddCommand(newCloseCmd())
 	cmd.AddCommand(newLogsCmd())
 	cmd.AddCommand(newStatusCmd())
+	cmd.AddCommand(newWaitCmd())
 	cmd.AddCommand(newLsCmd())
 	cmd.AddCommand(newDiffCmd())
 	cmd.AddCommand(newQuotaShowCmd())
@@ -65,24 +158,49 @@ func NewRootCmd() *cobra.Command {
 	cmd.AddCommand(newSecretsDeleteCmd())
 	cmd.AddCommand(newRmCmd())
 	cmd.AddCommand(newMvCmd())
+	cmd.AddCommand(newCreateCmd())
+	cmd.AddCommand(newUploadCmd())
+	cmd.AddCommand(newDownloadCmd())
+	cmd.AddCommand(newRunCmd())
+	cmd.AddCommand(newStopCmd())
+	cmd.AddCommand(newRestartCmd())
+	cmd.AddCommand(newValidateCmd())
+	cmd.AddCommand(newConfigCmd())
+	cmd.AddCommand(newLoginCmd())
+	cmd.AddCommand(newLogoutCmd())
 
 	return cmd
 }
 
 func (o *rootOptions) run(cmd *cobra.Command) error {
-	if err := setupLogger(o.logLevel); err != nil {
+	if err := setupLogger(o.logLevel, o.showToken); err != nil {
 		return err
 	}
 
-	if err := setupViper(); err != nil {
+	if err := setupViper(cmd); err != nil {
 		return err
 	}
 
+	if viper.GetBool("insecure") {
+		displayer.DisplayMessage(
+			"TLS certificate verification is disabled, the connection to the REANA server "+
+				"is insecure.",
+			displayer.Warning,
+			false,
+			cmd.ErrOrStderr(),
+		)
+	}
+
 	if err := validateFlags(cmd); err != nil {
 		return err
 	}
 
 	logCmdFlags(cmd)
+
+	token := cmd.Flags().Lookup("access-token")
+	if token != nil && !isOptionalFlag(token) && !viper.GetBool("skip-version-check") {
+		checkServerVersion(cmd, token.Value.String(), viper.GetString("server-url"))
+	}
 	return nil
 }
 
@@ -92,7 +210,7 @@ func validateFlags(cmd *cobra.Command) error {
 	serverURL := viper.GetString("server-url")
 	workflow := cmd.Flags().Lookup("workflow")
 
-	if token != nil {
+	if token != nil && !isOptionalFlag(token) {
 		if err := bindViperToCmdFlag(token); err != nil {
 			return err
 		}
@@ -104,13 +222,7 @@ func validateFlags(cmd *cobra.Command) error {
 			return err
 		}
 	}
-	if workflow != nil {
-		properties, ok := workflow.Annotations["properties"]
-		optional := ok && slices.Contains(properties, "optional")
-		if optional {
-			return nil
-		}
-
+	if workflow != nil && !isOptionalFlag(workflow) {
 		if err := bindViperToCmdFlag(workflow); err != nil {
 			return err
 		}
@@ -122,8 +234,19 @@ func validateFlags(cmd *cobra.Command) error {
 	return nil
 }
 
-// setupViper binds environment variable values to the viper keys.
-func setupViper() error {
+// neededByAnnotation annotation of an optional access token flag, listing the flags of the command
+// which need the access token when they are set.
+const neededByAnnotation = "neededBy"
+
+// isOptionalFlag checks if the flag is annotated as optional, in which case its value is not validated.
+func isOptionalFlag(f *pflag.Flag) bool {
+	properties, ok := f.Annotations["properties"]
+	return ok && slices.Contains(properties, "optional")
+}
+
+// setupViper binds environment variable values to the viper keys, and loads the values of the
+// selected context of the configuration file, which are used when no flags or environment variables are set.
+func setupViper(cmd *cobra.Command) error {
 	if err := viper.BindEnv("server-url", "REANA_SERVER_URL"); err != nil {
 		return err
 	}
@@ -133,11 +256,140 @@ func setupViper() error {
 	if err := viper.BindEnv("workflow", "REANA_WORKON"); err != nil {
 		return err
 	}
-	return nil
+	if err := viper.BindEnv("context", "REANA_CONTEXT"); err != nil {
+		return err
+	}
+	if err := viper.BindEnv("ca-cert", "REANA_CA_BUNDLE"); err != nil {
+		return err
+	}
+	if err := viper.BindEnv("client-cert", "REANA_CLIENT_CERT"); err != nil {
+		return err
+	}
+	if err := viper.BindEnv("client-key", "REANA_CLIENT_KEY"); err != nil {
+		return err
+	}
+	if err := viper.BindEnv("max-retries", "REANA_MAX_RETRIES"); err != nil {
+		return err
+	}
+	if err := viper.BindEnv("timeout", "REANA_TIMEOUT"); err != nil {
+		return err
+	}
+	if err := viper.BindEnv("credential-store", "REANA_CREDENTIAL_STORE"); err != nil {
+		return err
+	}
+	if err := viper.BindEnv("skip-version-check", "REANA_SKIP_VERSION_CHECK"); err != nil {
+		return err
+	}
+	// transport and credential options are read outside of the commands (e.g. by the API client),
+	// so flags are bound to viper instead. The flags of the root command are used, as commands can
+	// define their own flags with the same name (e.g. wait --timeout).
+	globalFlags := []string{
+		"ca-cert", "client-cert", "client-key", "insecure", "max-retries", "timeout", "proxy",
+		"credential-store", "show-token",
+	}
+	for _, name := range globalFlags {
+		if err := viper.BindPFlag(name, cmd.Root().PersistentFlags().Lookup(name)); err != nil {
+			return err
+		}
+	}
+
+	context, err := selectedContext(cmd)
+	if err != nil {
+		return err
+	}
+	if managesContexts(cmd) {
+		return nil
+	}
+	return loadContext(cmd, context)
+}
+
+// selectedContext returns the context given by --context or by the REANA_CONTEXT environment
+// variable. It is empty when the current context of the configuration file is used.
+func selectedContext(cmd *cobra.Command) (string, error) {
+	contextFlag := cmd.Flag("context")
+	if err := bindViperToCmdFlag(contextFlag); err != nil {
+		return "", err
+	}
+	return contextFlag.Value.String(), nil
+}
+
+// managesContexts checks if the command, or any of its parents, manages the contexts of the configuration
+// file itself, in which case the values of the selected context are not loaded.
+func managesContexts(cmd *cobra.Command) bool {
+	for c := cmd; c != nil; c = c.Parent() {
+		if _, ok := c.Annotations[contextsAnnotation]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+// loadContext merges the values of the context with the given name into viper, with a lower precedence
+// than flags and environment variables. The current context is used if name is empty.
+// The access token is read from the credential store of the context when it is needed, i.e. when
+// the command takes an access token and none is given.
+func loadContext(cmd *cobra.Command, name string) error {
+	path, err := contexts.DefaultPath()
+	if err != nil {
+		return err
+	}
+	cfg, err := contexts.Load(path)
+	if err != nil {
+		return err
+	}
+	if name == "" {
+		name = cfg.CurrentContext
+	}
+	context, found, err := cfg.Get(name)
+	if err != nil || !found {
+		return err
+	}
+	log.Debugf("Using context %s of %s", name, path)
+	if err := viper.MergeConfigMap(context.Values()); err != nil {
+		return err
+	}
+
+	backend := viper.GetString("credential-store")
+	if !needsAccessToken(cmd) || viper.IsSet("access-token") || backend == "" {
+		return nil
+	}
+	store, err := credentialStore(cmd, backend, path)
+	if err != nil {
+		return err
+	}
+	value, err := store.Get(name)
+	if errors.Is(err, credentials.ErrNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	log.Debugf("Using access token of context %s from the %s credential store", name, backend)
+	return viper.MergeConfigMap(map[string]any{"access-token": value})
+}
+
+// needsAccessToken checks if the command takes an access token and none is given with a flag.
+// An optional access token is only needed when one of the flags of its neededByAnnotation is set.
+func needsAccessToken(cmd *cobra.Command) bool {
+	token := cmd.Flags().Lookup("access-token")
+	if token == nil || token.Changed {
+		return false
+	}
+	if !isOptionalFlag(token) {
+		return true
+	}
+	for _, name := range token.Annotations[neededByAnnotation] {
+		if cmd.Flags().Changed(name) {
+			return true
+		}
+	}
+	return false
 }
 
 // setupLogger validates the logging level flag and configures the logger.
-func setupLogger(logLevelFlag string) error {
+// The access tokens are redacted from the log entries, e.g. from the dumped API requests, unless
+// showToken is set.
+func setupLogger(logLevelFlag string, showToken bool) error {
 	if err := validator.ValidateChoice(
 		logLevelFlag,
 		[]string{"DEBUG", "INFO", "WARNING"},
@@ -151,18 +403,38 @@ func setupLogger(logLevelFlag string) error {
 	}
 	log.SetLevel(level)
 	log.SetOutput(os.Stderr)
-	log.SetFormatter(&log.TextFormatter{
+	var logFormatter log.Formatter = &log.TextFormatter{
 		FullTimestamp:   true,
 		TimestampFormat: "2006-01-02 15:04:05.1234",
-	})
+	}
+	if !showToken {
+		logFormatter = redactingFormatter{logFormatter}
+	}
+	log.SetFormatter(logFormatter)
 	return nil
 }
 
-// logCmdFlags logs all the flags set in the given command.
+// redactingFormatter hides the access tokens passed as query parameters in the logged messages.
+type redactingFormatter struct {
+	log.Formatter
+}
+
+// Format redacts the access tokens of the message before formatting the entry.
+func (f redactingFormatter) Format(entry *log.Entry) ([]byte, error) {
+	entry.Message = formatter.RedactTokens(entry.Message)
+	return f.Formatter.Format(entry)
+}
+
+// logCmdFlags logs all the flags set in the given command, redacting the access token unless
+// --show-token is set.
 func logCmdFlags(cmd *cobra.Command) {
 	log.Debugf("command: %s", cmd.CalledAs())
 	cmd.Flags().Visit(func(f *pflag.Flag) {
-		log.Debugf("%s: %s", f.Name, f.Value)
+		value := f.Value.String()
+		if f.Name == "access-token" && !viper.GetBool("show-token") {
+			value = formatter.RedactToken(value)
+		}
+		log.Debugf("%s: %s", f.Name, value)
 	})
 }
 
@@ -10,11 +10,17 @@ package cmd
 
 import (
 	"bytes"
+	"encoding/json"
+	"encoding/pem"
+	"io"
 	"net/http"
 	"net/http/httptest"
 	"os"
+	"path/filepath"
+	"reanahub/reana-client-go/pkg/contexts"
 	"reanahub/reana-client-go/pkg/errorhandler"
 	"reanahub/reana-client-go/pkg/validator"
+	"reflect"
 	"strings"
 	"testing"
 
@@ -45,11 +51,59 @@ type TestCmdParams struct {
 	expected        []string
 	unwanted        []string
 	wantError       bool
+	input           string // standard input of the command
+	// config is saved in a temporary configuration file, which is empty when config is nil, so
+	// that the tests never read the configuration file of the user
+	config *contexts.Config
 }
 
 type ServerResponse struct {
 	statusCode   int
 	responseFile string
+	headers      map[string]string // additional headers, can override the default Content-Type
+	// expectedQuery and expectedBody are compared, when set, to the query parameters and to the
+	// JSON body of the request, so that the tests check what is sent to the server
+	expectedQuery map[string]string
+	expectedBody  string
+}
+
+// checkRequest checks that the request matches the expected query parameters and JSON body.
+func checkRequest(t *testing.T, r *http.Request, res ServerResponse) {
+	for key, expected := range res.expectedQuery {
+		if value := r.URL.Query().Get(key); value != expected {
+			t.Errorf("Expected query parameter %s='%s', got '%s'", key, expected, value)
+		}
+	}
+	if res.expectedBody == "" {
+		return
+	}
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		t.Errorf("Error while reading request body: %v", err)
+		return
+	}
+	var got, expected any
+	if err := json.Unmarshal(body, &got); err != nil {
+		t.Errorf("Expected JSON request body, got '%s'", body)
+		return
+	}
+	if err := json.Unmarshal([]byte(res.expectedBody), &expected); err != nil {
+		t.Fatalf("Invalid expected body: %v", err)
+	}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("Expected request body %s, got %s", res.expectedBody, body)
+	}
+}
+
+// writeServerCert writes the certificate of the test server to a temporary PEM file, so that it can
+// be used as CA bundle, and returns its path.
+func writeServerCert(t *testing.T, server *httptest.Server) string {
+	path := filepath.Join(t.TempDir(), "ca.pem")
+	cert := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})
+	if err := os.WriteFile(path, cert, 0600); err != nil {
+		t.Fatal(err)
+	}
+	return path
 }
 
 func testCmdRun(t *testing.T, p TestCmdParams) {
@@ -59,7 +113,11 @@ func testCmdRun(t *testing.T, p TestCmdParams) {
 		}
 		res, validPath := p.serverResponses[r.URL.Path]
 		if validPath {
+			checkRequest(t, r, res)
 			w.Header().Add("Content-Type", "application/json")
+			for key, value := range res.headers {
+				w.Header().Set(key, value)
+			}
 			w.WriteHeader(res.statusCode)
 
 			var body []byte
@@ -79,13 +137,20 @@ func testCmdRun(t *testing.T, p TestCmdParams) {
 		}
 	}))
 
+	writeTestConfig(t, p.config)
+	// the tests checking the version of the server override it with viper.Set
+	t.Setenv("REANA_SKIP_VERSION_CHECK", "true")
 	viper.Set("server-url", server.URL)
+	viper.Set("ca-cert", writeServerCert(t, server))
+	// error responses are part of the tested scenarios, so they must not be retried
+	viper.Set("max-retries", 0)
 	t.Cleanup(func() {
 		server.Close()
 		viper.Reset()
 	})
 
 	rootCmd := NewRootCmd()
+	rootCmd.SetIn(strings.NewReader(p.input))
 	args := append([]string{p.cmd, "-t", "1234"}, p.args...)
 	output, err := ExecuteCommand(rootCmd, args...)
 
@@ -225,8 +290,9 @@ func TestSetupViper(t *testing.T) {
 
 	for name, test := range tests {
 		t.Run(name, func(t *testing.T) {
+			t.Setenv(contexts.ConfigPathEnv, filepath.Join(t.TempDir(), "config.yaml"))
 			t.Setenv(test.env, test.value)
-			err := setupViper()
+			err := setupViper(NewRootCmd())
 			if err != nil {
 				t.Fatal(err)
 			}
@@ -248,6 +314,100 @@ func TestSetupViper(t *testing.T) {
 	}
 }
 
+func TestSetupViperContexts(t *testing.T) {
+	configFile := filepath.Join(t.TempDir(), "config.yaml")
+	cfg := &contexts.Config{
+		CurrentContext: "dev",
+		Contexts: map[string]contexts.Context{
+			"dev": {ServerURL: "https://reana-dev.cern.ch", AccessToken: "dev-token"},
+			"prod": {
+				ServerURL:   "https://reana.cern.ch",
+				AccessToken: "prod-token",
+				Workflow:    "analysis",
+			},
+		},
+	}
+	if err := cfg.Save(configFile); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := map[string]struct {
+		args      []string
+		env       map[string]string
+		expected  map[string]string
+		wantError string
+	}{
+		"current context": {
+			expected: map[string]string{
+				"server-url":   "https://reana-dev.cern.ch",
+				"access-token": "dev-token",
+				"workflow":     "",
+			},
+		},
+		"context flag": {
+			args: []string{"--context", "prod"},
+			expected: map[string]string{
+				"server-url":   "https://reana.cern.ch",
+				"access-token": "prod-token",
+				"workflow":     "analysis",
+			},
+		},
+		"context environment variable": {
+			env:      map[string]string{"REANA_CONTEXT": "prod"},
+			expected: map[string]string{"workflow": "analysis"},
+		},
+		"environment variables take precedence": {
+			args: []string{"--context", "prod"},
+			env: map[string]string{
+				"REANA_SERVER_URL": "https://localhost:8080",
+				"REANA_WORKON":     "other",
+			},
+			expected: map[string]string{
+				"server-url":   "https://localhost:8080",
+				"access-token": "prod-token",
+				"workflow":     "other",
+			},
+		},
+		"missing context": {
+			args:      []string{"--context", "staging"},
+			wantError: "context 'staging' does not exist",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Setenv(contexts.ConfigPathEnv, configFile)
+			for key, value := range test.env {
+				t.Setenv(key, value)
+			}
+			t.Cleanup(func() {
+				viper.Reset()
+			})
+
+			cmd := NewRootCmd()
+			if err := cmd.ParseFlags(test.args); err != nil {
+				t.Fatal(err)
+			}
+			err := setupViper(cmd)
+			if test.wantError != "" {
+				if err == nil || err.Error() != test.wantError {
+					t.Fatalf("Expected error '%s', instead got '%v'", test.wantError, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Got unexpected error '%s'", err.Error())
+			}
+
+			for key, value := range test.expected {
+				if viperValue := viper.GetString(key); viperValue != value {
+					t.Errorf("Expected '%s' to be '%s', instead got '%s'", key, value, viperValue)
+				}
+			}
+		})
+	}
+}
+
 func TestSetupLogger(t *testing.T) {
 	tests := map[string]struct {
 		level   string
@@ -260,7 +420,7 @@ func TestSetupLogger(t *testing.T) {
 
 	for name, test := range tests {
 		t.Run(name, func(t *testing.T) {
-			err := setupLogger(test.level)
+			err := setupLogger(test.level, false)
 			if test.isValid {
 				if err != nil {
 					t.Errorf("Got unexpected error '%s'", err.Error())
@@ -276,3 +436,65 @@ func TestSetupLogger(t *testing.T) {
 		})
 	}
 }
+
+func TestRedactTokensInLogs(t *testing.T) {
+	// the response body of /api/you, logged by the API client at DEBUG level
+	youBody, err := os.ReadFile("../testdata/inputs/whoami.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := map[string]struct {
+		showToken bool
+		expected  []string
+		unwanted  []string
+	}{
+		"redacted": {
+			expected: []string{
+				"access_token=REDACTED", "access-token: REDACTED", `\"value\": \"REDACTED\"`,
+			},
+			unwanted: []string{"1234", "secret"},
+		},
+		"show token": {
+			showToken: true,
+			expected: []string{
+				"access_token=secret", "access-token: secret", `\"value\": \"1234\"`,
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Cleanup(func() {
+				viper.Reset()
+				log.SetLevel(log.WarnLevel)
+				log.SetOutput(os.Stderr)
+			})
+			if err := setupLogger("DEBUG", test.showToken); err != nil {
+				t.Fatal(err)
+			}
+			output := new(bytes.Buffer)
+			log.SetOutput(output)
+			viper.Set("show-token", test.showToken)
+
+			log.Debugf("GET /api/you?access_token=secret HTTP/1.1")
+			log.Debugf("HTTP/1.1 200 OK\r\nContent-Type: application/json\r\n\r\n%s", youBody)
+			cmd := newStatusCmd()
+			if err := cmd.Flags().Set("access-token", "secret"); err != nil {
+				t.Fatal(err)
+			}
+			logCmdFlags(cmd)
+
+			for _, expected := range test.expected {
+				if !strings.Contains(output.String(), expected) {
+					t.Errorf("Expected '%s' in logs, instead got '%s'", expected, output)
+				}
+			}
+			for _, unwanted := range test.unwanted {
+				if strings.Contains(output.String(), unwanted) {
+					t.Errorf("Expected '%s' not to be in logs, instead got '%s'", unwanted, output)
+				}
+			}
+		})
+	}
+}
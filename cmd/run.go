@@ -0,0 +1,183 @@
+/*
+This file is part of REANA.
+Copyright (C) 2022 CERN.
+
+REANA is free software; you can redistribute it and/or modify it
+under the terms of the MIT License; see LICENSE file for more details.
+*/
+
+package cmd
+
+import (
+	"io"
+	"path/filepath"
+	"reanahub/reana-client-go/client"
+	"reanahub/reana-client-go/pkg/displayer"
+	"reanahub/reana-client-go/pkg/specification"
+	"reanahub/reana-client-go/pkg/validator"
+	"reanahub/reana-client-go/pkg/workflows"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+const runDesc = `
+Shortcut to create, upload, start a new workflow.
+
+The ` + "``run``" + ` command allows to create a new workflow, upload its input
+files and start it in one command. The input parameters and operational
+options are validated against the reana.yaml file before the workflow is
+created.
+
+Examples:
+
+$ reana-client run -n myanalysis-test-small -p myparam=mysmallvalue
+
+$ reana-client run -n myanalysis-test-big -p myparam=mybigvalue
+
+$ reana-client run -n myanalysis -f myreana.yaml -o CACHE=off --follow
+`
+
+type runOptions struct {
+	token      string
+	serverURL  string
+	file       string
+	name       string
+	parameters map[string]string
+	options    map[string]string
+	follow     bool
+}
+
+// newRunCmd creates a command to create, upload and start a new workflow.
+func newRunCmd() *cobra.Command {
+	o := &runOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "run",
+		Short: "Shortcut to create, upload, start a new workflow.",
+		Long:  runDesc,
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := validator.ValidateWorkflowName(o.name); err != nil {
+				return err
+			}
+			o.serverURL = viper.GetString("server-url")
+			return o.run(cmd)
+		},
+	}
+
+	f := cmd.Flags()
+	f.StringVarP(&o.token, "access-token", "t", "", "Access token of the current user.")
+	f.StringVarP(
+		&o.file,
+		"file",
+		"f",
+		"reana.yaml",
+		"REANA specification file describing the workflow to execute.",
+	)
+	f.StringVarP(
+		&o.name,
+		"name",
+		"n",
+		"",
+		`Optional name of the workflow. [default is "workflow"]`,
+	)
+	f.StringToStringVarP(
+		&o.parameters,
+		"parameter",
+		"p",
+		map[string]string{},
+		`Additional input parameters to override original ones from reana.yaml.
+E.g. -p myparam1=myval1 -p myparam2=myval2.`,
+	)
+	f.StringToStringVarP(
+		&o.options,
+		"option",
+		"o",
+		map[string]string{},
+		`Additional operational options for the workflow execution.
+E.g. CACHE=off. (workflow engine - serial)
+E.g. --debug (workflow engine - cwl)`,
+	)
+	f.BoolVar(
+		&o.follow,
+		"follow",
+		false,
+		"If set, follows the execution of the workflow until termination.",
+	)
+
+	return cmd
+}
+
+func (o *runOptions) run(cmd *cobra.Command) error {
+	spec, err := specification.Load(o.file)
+	if err != nil {
+		return err
+	}
+
+	options, parameters, err := validateSpecOptionsAndParams(
+		spec,
+		o.options, o.parameters,
+		cmd.OutOrStdout(),
+	)
+	if err != nil {
+		return err
+	}
+
+	displayer.DisplayMessage("Creating a workflow...", displayer.Info, false, cmd.OutOrStdout())
+	workflow, err := createWorkflow(cmd.Context(), o.token, o.name, spec)
+	if err != nil {
+		return err
+	}
+	createdMsg, err := workflows.StatusChangeMessage(workflow, "created")
+	if err != nil {
+		return err
+	}
+	displayer.DisplayMessage(createdMsg, displayer.Success, false, cmd.OutOrStdout())
+
+	var inputs []string
+	inputs = append(inputs, spec.Inputs.Files...)
+	inputs = append(inputs, spec.Inputs.Directories...)
+	if len(inputs) > 0 {
+		displayer.DisplayMessage("Uploading files...", displayer.Info, false, cmd.OutOrStdout())
+		err = uploadFiles(cmd, o.token, workflow, filepath.Dir(o.file), inputs, false)
+		if err != nil {
+			return err
+		}
+	}
+
+	displayer.DisplayMessage("Starting workflow...", displayer.Info, false, cmd.OutOrStdout())
+	api, err := client.ApiClient()
+	if err != nil {
+		return err
+	}
+	return startWorkflow(
+		cmd,
+		api,
+		o.token, o.serverURL, workflow,
+		parameters, options,
+		o.follow,
+	)
+}
+
+// validateSpecOptionsAndParams validates the options and params provided against the given local specification.
+// Works like validateStartOptionsAndParams, without having to get the workflow parameters from the server.
+func validateSpecOptionsAndParams(
+	spec *specification.Specification,
+	options, inputParams map[string]string,
+	out io.Writer,
+) (validatedOptions map[string]string, validatedParams map[string]string, err error) {
+	validatedOptions, err = validator.ValidateOperationalOptions(spec.Workflow.Type, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	validatedParams, errorList := validator.ValidateInputParameters(
+		inputParams,
+		spec.Inputs.Parameters,
+	)
+	for _, err := range errorList {
+		displayer.DisplayMessage(err.Error(), displayer.Error, false, out)
+	}
+	return validatedOptions, validatedParams, nil
+}
@@ -0,0 +1,127 @@
+/*
+This file is part of REANA.
+Copyright (C) 2022 CERN.
+
+REANA is free software; you can redistribute it and/or modify it
+under the terms of the MIT License; see LICENSE file for more details.
+*/
+
+package cmd
+
+import (
+	"fmt"
+	"net/http"
+	"reanahub/reana-client-go/pkg/config"
+	"testing"
+)
+
+func TestRun(t *testing.T) {
+	// Deactivate the sleep used with the --follow flag
+	oldInterval := config.CheckInterval
+	config.CheckInterval = 0
+	t.Cleanup(func() {
+		config.CheckInterval = oldInterval
+	})
+
+	workflowName := "my_workflow.1"
+	specFile := "../testdata/specs/reana.yaml"
+	runResponses := map[string]ServerResponse{
+		createPath: {
+			statusCode:   http.StatusCreated,
+			responseFile: "create_success.json",
+		},
+		fmt.Sprintf(uploadPathTemplate, workflowName): {
+			statusCode:   http.StatusOK,
+			responseFile: "upload_success.json",
+		},
+		fmt.Sprintf(startPathTemplate, workflowName): {
+			statusCode:   http.StatusOK,
+			responseFile: "start_success.json",
+		},
+	}
+
+	tests := map[string]TestCmdParams{
+		"default": {
+			serverResponses: runResponses,
+			args:            []string{"-f", specFile},
+			expected: []string{
+				"Creating a workflow...",
+				workflowName + " has been created",
+				"Uploading files...",
+				"File code/helloworld.py (22) was successfully uploaded.",
+				"File data/names.txt (10) was successfully uploaded.",
+				"Starting workflow...",
+				workflowName + " is running",
+			},
+		},
+		"no inputs to upload": {
+			serverResponses: runResponses,
+			args:            []string{"-f", "../testdata/specs/reana_workflow_file.yaml"},
+			expected: []string{
+				workflowName + " has been created",
+				workflowName + " is running",
+			},
+			unwanted: []string{"Uploading files..."},
+		},
+		"valid parameters and options": {
+			serverResponses: runResponses,
+			args:            []string{"-f", specFile, "-p", "sleeptime=2", "-o", "CACHE=off"},
+			expected: []string{
+				workflowName + " is running",
+			},
+			unwanted: []string{"is not in reana.yaml"},
+		},
+		"parameter not in reana.yaml": {
+			serverResponses: runResponses,
+			args:            []string{"-f", specFile, "-p", "invalid=2"},
+			expected: []string{
+				"given parameter - invalid, is not in reana.yaml",
+				workflowName + " is running",
+			},
+		},
+		"option not supported for workflow type": {
+			args: []string{"-f", specFile, "-o", "report=report"},
+			expected: []string{
+				"operational option 'report' not supported for serial workflows",
+			},
+			wantError: true,
+		},
+		"invalid name": {
+			args:      []string{"-f", specFile, "-n", "my_workflow.1"},
+			expected:  []string{"workflow name 'my_workflow.1' cannot contain dots"},
+			wantError: true,
+		},
+		"follow finished": {
+			// The workspace listing after finishing shares the path of the uploads
+			serverResponses: map[string]ServerResponse{
+				createPath: runResponses[createPath],
+				fmt.Sprintf(uploadPathTemplate, workflowName): {
+					statusCode:   http.StatusOK,
+					responseFile: "ls_complete.json",
+				},
+				fmt.Sprintf(startPathTemplate, workflowName): {
+					statusCode:   http.StatusOK,
+					responseFile: "start_success.json",
+				},
+				fmt.Sprintf(statusPathTemplate, workflowName): {
+					statusCode:   http.StatusOK,
+					responseFile: "status_finished.json",
+				},
+			},
+			args: []string{"-f", specFile, "--follow"},
+			expected: []string{
+				workflowName + " is running",
+				workflowName + " has finished",
+				"Listing workflow output files...",
+				"/api/workflows/my_workflow.1/workspace/results/data.root",
+			},
+		},
+	}
+
+	for name, params := range tests {
+		t.Run(name, func(t *testing.T) {
+			params.cmd = "run"
+			testCmdRun(t, params)
+		})
+	}
+}
@@ -86,7 +86,7 @@ func (o *secretsAddOptions) run(cmd *cobra.Command) error {
 		return err
 	}
 
-	addSecretsParams := operations.NewAddSecretsParams()
+	addSecretsParams := operations.NewAddSecretsParamsWithContext(cmd.Context())
 	addSecretsParams.SetAccessToken(&o.token)
 	addSecretsParams.SetOverwrite(&o.overwrite)
 	addSecretsParams.SetSecrets(secrets)
@@ -53,7 +53,7 @@ func newSecretsDeleteCmd() *cobra.Command {
 }
 
 func (o *secretsDeleteOptions) run(cmd *cobra.Command) error {
-	deleteSecretsParams := operations.NewDeleteSecretsParams()
+	deleteSecretsParams := operations.NewDeleteSecretsParamsWithContext(cmd.Context())
 	deleteSecretsParams.SetAccessToken(&o.token)
 	deleteSecretsParams.SetSecrets(o.secrets)
 
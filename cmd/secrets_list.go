@@ -25,7 +25,8 @@ Examples:
 `
 
 type secretsListOptions struct {
-	token string
+	token  string
+	output string
 }
 
 // newSecretsListCmd creates a command to list user secrets.
@@ -44,12 +45,18 @@ func newSecretsListCmd() *cobra.Command {
 
 	f := cmd.Flags()
 	f.StringVarP(&o.token, "access-token", "t", "", "Access token of the current user.")
+	addOutputFlag(f, &o.output)
 
 	return cmd
 }
 
 func (o *secretsListOptions) run(cmd *cobra.Command) error {
-	listSecretsParams := operations.NewGetSecretsParams()
+	format, err := outputFormat(cmd.Flags(), o.output, false)
+	if err != nil {
+		return err
+	}
+
+	listSecretsParams := operations.NewGetSecretsParamsWithContext(cmd.Context())
 	listSecretsParams.SetAccessToken(&o.token)
 
 	api, err := client.ApiClient()
@@ -61,13 +68,5 @@ func (o *secretsListOptions) run(cmd *cobra.Command) error {
 		return err
 	}
 
-	header := []string{"name", "type"}
-	var rows [][]string
-	for _, secret := range listSecretsResp.Payload {
-		row := []string{secret.Name, secret.Type}
-		rows = append(rows, row)
-	}
-	displayer.DisplayTable(header, rows, cmd.OutOrStdout())
-
-	return nil
+	return displayer.DisplayOutput(listSecretsResp.Payload, format, cmd.OutOrStdout())
 }
@@ -30,6 +30,26 @@ func TestSecretsList(t *testing.T) {
 				"secret2", "file",
 			},
 		},
+		"yaml": {
+			serverResponses: map[string]ServerResponse{
+				secretsListServerPath: {
+					statusCode:   http.StatusOK,
+					responseFile: "secrets_list.json",
+				},
+			},
+			args:     []string{"-o", "yaml"},
+			expected: []string{"- name: secret1\n  type: env\n- name: secret2\n  type: file\n"},
+		},
+		"tsv": {
+			serverResponses: map[string]ServerResponse{
+				secretsListServerPath: {
+					statusCode:   http.StatusOK,
+					responseFile: "secrets_list.json",
+				},
+			},
+			args:     []string{"-o", "tsv"},
+			expected: []string{"name\ttype\nsecret1\tenv\nsecret2\tfile\n"},
+		},
 		"unexpected args": {
 			args:      []string{"arg"},
 			expected:  []string{"unknown command \"arg\" for \"reana-client secrets-list\""},
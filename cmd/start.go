@@ -9,6 +9,7 @@ under the terms of the MIT License; see LICENSE file for more details.
 package cmd
 
 import (
+	"context"
 	"errors"
 	"io"
 	"reanahub/reana-client-go/client"
@@ -110,6 +111,7 @@ func (o *startOptions) run(cmd *cobra.Command) error {
 
 	if len(o.parameters) > 0 || len(o.options) > 0 {
 		o.options, o.parameters, err = validateStartOptionsAndParams(
+			cmd.Context(),
 			api,
 			o.token, o.workflow, o.options, o.parameters,
 			cmd.OutOrStdout(),
@@ -119,12 +121,30 @@ func (o *startOptions) run(cmd *cobra.Command) error {
 		}
 	}
 
-	startParams := operations.NewStartWorkflowParams()
-	startParams.SetAccessToken(&o.token)
-	startParams.SetWorkflowIDOrName(o.workflow)
+	return startWorkflow(
+		cmd,
+		api,
+		o.token, o.serverURL, o.workflow,
+		o.parameters, o.options,
+		o.follow,
+	)
+}
+
+// startWorkflow starts the workflow with the given input parameters and operational options, which should be
+// validated beforehand. If follow is set to true, it also follows the execution of the workflow.
+func startWorkflow(
+	cmd *cobra.Command,
+	api *client.API,
+	token, serverURL, workflow string,
+	parameters, options map[string]string,
+	follow bool,
+) error {
+	startParams := operations.NewStartWorkflowParamsWithContext(cmd.Context())
+	startParams.SetAccessToken(&token)
+	startParams.SetWorkflowIDOrName(workflow)
 	startParams.SetParameters(operations.StartWorkflowBody{
-		InputParameters:    o.parameters,
-		OperationalOptions: o.options,
+		InputParameters:    parameters,
+		OperationalOptions: options,
 	})
 	startResp, err := api.Operations.StartWorkflow(startParams)
 	if err != nil {
@@ -132,7 +152,7 @@ func (o *startOptions) run(cmd *cobra.Command) error {
 	}
 
 	currentStatus := startResp.Payload.Status
-	statusMsg, err := workflows.StatusChangeMessage(o.workflow, currentStatus)
+	statusMsg, err := workflows.StatusChangeMessage(workflow, currentStatus)
 	if err != nil {
 		return err
 	}
@@ -147,8 +167,8 @@ func (o *startOptions) run(cmd *cobra.Command) error {
 		return errors.New(statusMsg)
 	}
 
-	if o.follow {
-		err = followWorkflowExecution(cmd, currentStatus, o.token, o.serverURL, o.workflow)
+	if follow {
+		err = followWorkflowExecution(cmd, currentStatus, token, serverURL, workflow)
 		if err != nil {
 			return err
 		}
@@ -161,12 +181,13 @@ func (o *startOptions) run(cmd *cobra.Command) error {
 // For operations options, it returns an error if any of them aren't valid. Translated options if necessary.
 // For input parameters, simply displays errors if any and continues execution.
 func validateStartOptionsAndParams(
+	ctx context.Context,
 	api *client.API,
 	token, workflow string,
 	options, inputParams map[string]string,
 	out io.Writer,
 ) (validatedOptions map[string]string, validatedParams map[string]string, err error) {
-	params := operations.NewGetWorkflowParametersParams()
+	params := operations.NewGetWorkflowParametersParamsWithContext(ctx)
 	params.SetAccessToken(&token)
 	params.SetWorkflowIDOrName(workflow)
 	paramsResp, err := api.Operations.GetWorkflowParameters(params)
@@ -198,8 +219,12 @@ func followWorkflowExecution(
 	token, serverURL, workflow string,
 ) error {
 	for slices.Contains([]string{"pending", "queued", "running"}, currentStatus) {
-		time.Sleep(time.Duration(config.CheckInterval) * time.Second)
-		status, err := workflows.GetStatus(token, workflow)
+		select {
+		case <-cmd.Context().Done():
+			return cmd.Context().Err()
+		case <-time.After(time.Duration(config.CheckInterval) * time.Second):
+		}
+		status, err := workflows.GetStatus(cmd.Context(), token, workflow)
 		if err != nil {
 			return err
 		}
@@ -232,6 +257,7 @@ func followWorkflowExecution(
 			token:       token,
 			serverURL:   serverURL,
 			workflow:    workflow,
+			output:      string(displayer.TableFormat),
 			displayURLs: true,
 			page:        1,
 		}
@@ -9,10 +9,14 @@ under the terms of the MIT License; see LICENSE file for more details.
 package cmd
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"net/http"
 	"reanahub/reana-client-go/pkg/config"
 	"testing"
+
+	"github.com/spf13/cobra"
 )
 
 var startPathTemplate = "/api/workflows/%s/start"
@@ -220,3 +224,15 @@ func TestStart(t *testing.T) {
 		})
 	}
 }
+
+func TestFollowWorkflowExecutionInterrupted(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	cmd := &cobra.Command{}
+	cmd.SetContext(ctx)
+
+	err := followWorkflowExecution(cmd, "running", "1234", "https://localhost", "my_workflow")
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("Expected context cancelled error, instead got %v", err)
+	}
+}
@@ -11,10 +11,12 @@ package cmd
 import (
 	"fmt"
 	"reanahub/reana-client-go/client/operations"
+	"reanahub/reana-client-go/pkg/config"
 	"reanahub/reana-client-go/pkg/displayer"
 	"reanahub/reana-client-go/pkg/formatter"
 	"reanahub/reana-client-go/pkg/workflows"
 	"strings"
+	"time"
 
 	"golang.org/x/exp/slices"
 
@@ -36,6 +38,10 @@ Examples:
   $ reana-client status -w myanalysis.42
 
   $ reana-client status -w myanalysis.42 -v --json
+
+  $ reana-client status -w myanalysis.42 --output yaml
+
+  $ reana-client status -w myanalysis.42 --watch
 `
 
 const statusFormatFlagDesc = `Format output by displaying only certain columns.
@@ -46,8 +52,10 @@ type statusOptions struct {
 	workflow        string
 	formatFilters   []string
 	jsonOutput      bool
+	output          string
 	verbose         bool
 	includeDuration bool
+	watch           bool
 }
 
 // newStatusCmd creates a command to get status of a workflow.
@@ -74,6 +82,7 @@ func newStatusCmd() *cobra.Command {
 	)
 	f.StringSliceVar(&o.formatFilters, "format", []string{}, statusFormatFlagDesc)
 	f.BoolVar(&o.jsonOutput, "json", false, "Get output in JSON format.")
+	addOutputFlag(f, &o.output)
 	f.BoolVarP(&o.verbose, "verbose", "v", false, "Set status information verbosity.")
 	f.BoolVar(
 		&o.includeDuration,
@@ -82,12 +91,33 @@ func newStatusCmd() *cobra.Command {
 		`Include the duration of the workflows in seconds.
 In case a workflow is in progress, its duration as of now will be shown.`,
 	)
+	f.BoolVar(
+		&o.watch,
+		"watch",
+		false,
+		`Refresh the status until the workflow ends, showing its current step
+and command, the number of jobs, the elapsed time and a progress bar.`,
+	)
 
 	return cmd
 }
 
 func (o *statusOptions) run(cmd *cobra.Command) error {
-	payload, err := workflows.GetStatus(o.token, o.workflow)
+	format, err := outputFormat(cmd.Flags(), o.output, o.jsonOutput)
+	if err != nil {
+		return err
+	}
+	if format == displayer.WideFormat {
+		o.verbose = true
+	}
+	if o.watch {
+		if format != displayer.TableFormat {
+			return fmt.Errorf("--watch cannot be used with the %s output format", format)
+		}
+		return o.watchStatus(cmd)
+	}
+
+	payload, err := workflows.GetStatus(cmd.Context(), o.token, o.workflow)
 	if err != nil {
 		return err
 	}
@@ -104,7 +134,7 @@ func (o *statusOptions) run(cmd *cobra.Command) error {
 		payload,
 		header,
 		parsedFormatFilters,
-		o.jsonOutput,
+		format,
 	)
 	if err != nil {
 		return err
@@ -119,7 +149,7 @@ func displayStatusPayload(
 	p *operations.GetWorkflowStatusOKBody,
 	header []string,
 	filters []formatter.FormatFilter,
-	jsonOutput bool,
+	format displayer.OutputFormat,
 ) error {
 	var df dataframe.DataFrame
 	for _, col := range header {
@@ -168,17 +198,83 @@ func displayStatusPayload(
 		return err
 	}
 
-	if jsonOutput {
-		err := displayer.DisplayJsonOutput(df.Maps(), cmd.OutOrStdout())
+	return displayer.DisplayPayloadOutput(df, p, format, cmd.OutOrStdout())
+}
+
+// watchStatus displays the status of the workflow in a dashboard, refreshed every
+// config.CheckInterval seconds until the workflow ends.
+func (o *statusOptions) watchStatus(cmd *cobra.Command) error {
+	dashboard := displayer.NewDashboard(cmd.OutOrStdout())
+	for {
+		payload, err := workflows.GetStatus(cmd.Context(), o.token, o.workflow)
 		if err != nil {
 			return err
 		}
-	} else {
-		data := formatter.DataFrameToStringData(df)
-		displayer.DisplayTable(df.Names(), data, cmd.OutOrStdout())
+		lines, summary, err := buildStatusDashboard(payload)
+		if err != nil {
+			return err
+		}
+		dashboard.Update(lines, summary)
+
+		if !slices.Contains([]string{"created", "pending", "queued", "running"}, payload.Status) {
+			return nil
+		}
+		select {
+		case <-cmd.Context().Done():
+			return cmd.Context().Err()
+		case <-time.After(time.Duration(config.CheckInterval) * time.Second):
+		}
 	}
+}
 
-	return nil
+// buildStatusDashboard returns the lines of the status dashboard, and the summary line displayed
+// instead when the output is not a terminal.
+func buildStatusDashboard(p *operations.GetWorkflowStatusOKBody) ([]string, string, error) {
+	var finished, running, failed, total int64
+	if p.Progress.Finished != nil {
+		finished = p.Progress.Finished.Total
+	}
+	if p.Progress.Running != nil {
+		running = p.Progress.Running.Total
+	}
+	if p.Progress.Failed != nil {
+		failed = p.Progress.Failed.Total
+	}
+	if p.Progress.Total != nil {
+		total = p.Progress.Total.Total
+	}
+
+	step, command := "-", "-"
+	if p.Progress.CurrentStepName != nil {
+		step = *p.Progress.CurrentStepName
+	}
+	if p.Progress.CurrentCommand != nil || p.Progress.CurrentStepName != nil {
+		command = getStatusCommand(p.Progress)
+	}
+
+	elapsed := "-"
+	duration, err := workflows.GetDuration(p.Progress.RunStartedAt, p.Progress.RunFinishedAt)
+	if err != nil {
+		return nil, "", err
+	}
+	if seconds, ok := duration.(float64); ok {
+		elapsed = (time.Duration(seconds) * time.Second).String()
+	}
+
+	jobs := fmt.Sprintf(
+		"%d finished, %d running, %d failed, %d total", finished, running, failed, total,
+	)
+	lines := []string{
+		fmt.Sprintf("Workflow:  %s (%s)", p.Name, p.Status),
+		fmt.Sprintf("Step:      %s", step),
+		fmt.Sprintf("Command:   %s", command),
+		fmt.Sprintf("Jobs:      %s", jobs),
+		fmt.Sprintf("Elapsed:   %s", elapsed),
+		fmt.Sprintf("Progress:  %s", displayer.ProgressBar(finished, total, 30)),
+	}
+	// the elapsed time is not part of the summary, so that it is only printed on changes
+	summary := fmt.Sprintf("%s is %s, step: %s, jobs: %s", p.Name, p.Status, step, jobs)
+	return lines, summary, nil
 }
 
 // buildStatusHeader builds the header of the status table, according to whether to include
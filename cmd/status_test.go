@@ -91,6 +91,29 @@ func TestStatus(t *testing.T) {
 ]
 `},
 		},
+		"wide": {
+			serverResponses: map[string]ServerResponse{
+				fmt.Sprintf(statusPathTemplate, workflowName): {
+					statusCode:   http.StatusOK,
+					responseFile: "status_finished.json",
+				},
+			},
+			args: []string{"-w", workflowName, "-o", "wide"},
+			expected: []string{
+				"NAME", "RUN_NUMBER", "CREATED", "STARTED", "ENDED", "STATUS", "PROGRESS",
+				"ID", "USER", "DURATION",
+			},
+		},
+		"ndjson": {
+			serverResponses: map[string]ServerResponse{
+				fmt.Sprintf(statusPathTemplate, workflowName): {
+					statusCode:   http.StatusOK,
+					responseFile: "status_finished.json",
+				},
+			},
+			args:     []string{"-w", workflowName, "-o", "ndjson", "--format", "name,status"},
+			expected: []string{`{"name":"my_workflow","status":"finished"}` + "\n"},
+		},
 		"verbose": {
 			serverResponses: map[string]ServerResponse{
 				fmt.Sprintf(statusPathTemplate, workflowName): {
@@ -126,6 +149,25 @@ func TestStatus(t *testing.T) {
 				"my_workflow_id", "user", "ls",
 			},
 		},
+		"watch": {
+			serverResponses: map[string]ServerResponse{
+				fmt.Sprintf(statusPathTemplate, workflowName): {
+					statusCode:   http.StatusOK,
+					responseFile: "status_finished.json",
+				},
+			},
+			args: []string{"-w", workflowName, "--watch"},
+			expected: []string{
+				"my_workflow.10 is finished, step: step_name, " +
+					"jobs: 2 finished, 2 running, 0 failed, 2 total\n",
+			},
+			unwanted: []string{"NAME", "Progress:"},
+		},
+		"watch with json": {
+			args:      []string{"-w", workflowName, "--watch", "--json"},
+			expected:  []string{"--watch cannot be used with the json output format"},
+			wantError: true,
+		},
 		"unexisting workflow": {
 			serverResponses: map[string]ServerResponse{
 				fmt.Sprintf(statusPathTemplate, "invalid"): {
@@ -346,3 +388,74 @@ func TestBuildStatusSeries(t *testing.T) {
 		})
 	}
 }
+
+func TestBuildStatusDashboard(t *testing.T) {
+	stepStr := "fit"
+	bashCmd := "bash -c \"cd folder; python fit.py \""
+	startedAt := "2022-07-20T12:09:09"
+	finishedAt := "2022-07-20T12:10:24"
+
+	tests := map[string]struct {
+		payload  operations.GetWorkflowStatusOKBody
+		expected []string
+		summary  string
+	}{
+		"not started": {
+			payload: operations.GetWorkflowStatusOKBody{
+				Name:     "my_workflow.1",
+				Status:   "created",
+				Progress: &operations.GetWorkflowStatusOKBodyProgress{},
+			},
+			expected: []string{
+				"Workflow:  my_workflow.1 (created)",
+				"Step:      -",
+				"Command:   -",
+				"Jobs:      0 finished, 0 running, 0 failed, 0 total",
+				"Elapsed:   -",
+				"Progress:  [------------------------------]   0%",
+			},
+			summary: "my_workflow.1 is created, step: -, " +
+				"jobs: 0 finished, 0 running, 0 failed, 0 total",
+		},
+		"ended run": {
+			payload: operations.GetWorkflowStatusOKBody{
+				Name:   "my_workflow.2",
+				Status: "failed",
+				Progress: &operations.GetWorkflowStatusOKBodyProgress{
+					CurrentStepName: &stepStr,
+					CurrentCommand:  &bashCmd,
+					RunStartedAt:    &startedAt,
+					RunFinishedAt:   &finishedAt,
+					Finished:        &operations.GetWorkflowStatusOKBodyProgressFinished{Total: 1},
+					Failed:          &operations.GetWorkflowStatusOKBodyProgressFailed{Total: 1},
+					Total:           &operations.GetWorkflowStatusOKBodyProgressTotal{Total: 3},
+				},
+			},
+			expected: []string{
+				"Workflow:  my_workflow.2 (failed)",
+				"Step:      fit",
+				"Command:   python fit.py",
+				"Jobs:      1 finished, 0 running, 1 failed, 3 total",
+				"Elapsed:   1m15s",
+				"Progress:  [##########--------------------]  33%",
+			},
+			summary: "my_workflow.2 is failed, step: fit, " +
+				"jobs: 1 finished, 0 running, 1 failed, 3 total",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			lines, summary, err := buildStatusDashboard(&test.payload)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if !slices.Equal(lines, test.expected) {
+				t.Errorf("expected lines:\n%v\ngot:\n%v", test.expected, lines)
+			}
+			if summary != test.summary {
+				t.Errorf("expected summary '%s', got '%s'", test.summary, summary)
+			}
+		})
+	}
+}
@@ -0,0 +1,58 @@
+/*
+This file is part of REANA.
+Copyright (C) 2022 CERN.
+
+REANA is free software; you can redistribute it and/or modify it
+under the terms of the MIT License; see LICENSE file for more details.
+*/
+
+package cmd
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestStop(t *testing.T) {
+	workflowName := "my_workflow"
+	tests := map[string]TestCmdParams{
+		"force stop": {
+			serverResponses: map[string]ServerResponse{
+				fmt.Sprintf(statusPathTemplate, workflowName): {
+					statusCode:    http.StatusOK,
+					responseFile:  "stop_success.json",
+					expectedQuery: map[string]string{"status": "stop"},
+					expectedBody:  `{"force_stop": true}`,
+				},
+			},
+			args:     []string{"-w", workflowName, "--force"},
+			expected: []string{workflowName + " has been stopped"},
+		},
+		"without force": {
+			args:      []string{"-w", workflowName},
+			expected:  []string{"graceful stop not implemented yet"},
+			wantError: true,
+		},
+		"invalid workflow": {
+			serverResponses: map[string]ServerResponse{
+				fmt.Sprintf(statusPathTemplate, "invalid"): {
+					statusCode:   http.StatusNotFound,
+					responseFile: "common_invalid_workflow.json",
+				},
+			},
+			args: []string{"-w", "invalid", "--force"},
+			expected: []string{
+				"REANA_WORKON is set to invalid, but that workflow does not exist.",
+			},
+			wantError: true,
+		},
+	}
+
+	for name, params := range tests {
+		t.Run(name, func(t *testing.T) {
+			params.cmd = "stop"
+			testCmdRun(t, params)
+		})
+	}
+}
@@ -0,0 +1,253 @@
+/*
+This file is part of REANA.
+Copyright (C) 2022 CERN.
+
+REANA is free software; you can redistribute it and/or modify it
+under the terms of the MIT License; see LICENSE file for more details.
+*/
+
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"reanahub/reana-client-go/client"
+	"reanahub/reana-client-go/client/operations"
+	"reanahub/reana-client-go/pkg/config"
+	"reanahub/reana-client-go/pkg/datautils"
+	"reanahub/reana-client-go/pkg/displayer"
+	"reanahub/reana-client-go/pkg/formatter"
+	"reanahub/reana-client-go/pkg/specification"
+	"strings"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+)
+
+const uploadDesc = `
+Upload files and directories to workspace.
+
+The ` + "``upload``" + ` command allows to upload workflow input files and
+directories. The SOURCES argument can be repeated and specifies which files
+and directories are to be uploaded, see examples below. The default
+behaviour is to upload all input files and directories specified in the
+reana.yaml file. Directories are uploaded recursively.
+
+Examples:
+
+$ reana-client upload -w myanalysis.42
+
+$ reana-client upload -w myanalysis.42 code/mycode.py
+
+$ reana-client upload -w myanalysis.42 -f myreana.yaml
+`
+
+type uploadOptions struct {
+	token         string
+	workflow      string
+	file          string
+	paths         []string
+	humanReadable bool
+}
+
+// newUploadCmd creates a command to upload files and directories to the workspace.
+func newUploadCmd() *cobra.Command {
+	o := &uploadOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "upload [SOURCES...]",
+		Short: "Upload files and directories to workspace.",
+		Long:  uploadDesc,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			o.paths = args
+			return o.run(cmd)
+		},
+	}
+
+	f := cmd.Flags()
+	f.StringVarP(&o.token, "access-token", "t", "", "Access token of the current user.")
+	f.StringVarP(
+		&o.workflow,
+		"workflow",
+		"w", "",
+		"Name or UUID of the workflow. Overrides value of REANA_WORKON environment variable.",
+	)
+	f.StringVarP(
+		&o.file,
+		"file",
+		"f",
+		"reana.yaml",
+		"REANA specification file listing the inputs to upload when no SOURCES are given.",
+	)
+	f.BoolVarP(
+		&o.humanReadable,
+		"human-readable",
+		"h",
+		false,
+		"Show file size in human readable format.",
+	)
+	// Remove -h shorthand
+	cmd.PersistentFlags().BoolP("help", "", false, "Help for upload")
+
+	return cmd
+}
+
+func (o *uploadOptions) run(cmd *cobra.Command) error {
+	baseDir := "."
+	paths := o.paths
+	if len(paths) == 0 {
+		spec, err := specification.Load(o.file)
+		if err != nil {
+			return err
+		}
+		baseDir = filepath.Dir(o.file)
+		paths = append(paths, spec.Inputs.Files...)
+		paths = append(paths, spec.Inputs.Directories...)
+		if len(paths) == 0 {
+			return fmt.Errorf("no input files or directories are specified in '%s'", o.file)
+		}
+	} else {
+		var err error
+		paths, err = getWorkspacePaths(paths)
+		if err != nil {
+			return err
+		}
+	}
+
+	return uploadFiles(cmd, o.token, o.workflow, baseDir, paths, o.humanReadable)
+}
+
+// uploadFiles uploads the given paths, relative to baseDir, to the workspace of the workflow.
+// Directories are walked recursively and files in config.FilesBlacklist are skipped.
+func uploadFiles(
+	cmd *cobra.Command,
+	token, workflow, baseDir string,
+	paths []string,
+	humanReadable bool,
+) error {
+	files, err := collectUploadFiles(baseDir, paths, cmd.OutOrStdout())
+	if err != nil {
+		return err
+	}
+
+	api, err := client.ApiClient()
+	if err != nil {
+		return err
+	}
+
+	for _, fileName := range files {
+		content, err := os.ReadFile(filepath.Join(baseDir, filepath.FromSlash(fileName)))
+		if err != nil {
+			return err
+		}
+
+		uploadParams := operations.NewUploadFileParamsWithContext(cmd.Context())
+		uploadParams.SetAccessToken(&token)
+		uploadParams.SetWorkflowIDOrName(workflow)
+		uploadParams.SetFileName(fileName)
+		uploadParams.SetFile(string(content))
+		_, err = api.Operations.UploadFile(uploadParams)
+		if err != nil {
+			return err
+		}
+
+		size := fmt.Sprintf("%d", len(content))
+		if humanReadable {
+			size = formatter.FormatFileSize(int64(len(content)))
+		}
+		displayer.DisplayMessage(
+			fmt.Sprintf("File %s (%s) was successfully uploaded.", fileName, size),
+			displayer.Success,
+			false,
+			cmd.OutOrStdout(),
+		)
+	}
+
+	return nil
+}
+
+// collectUploadFiles walks the given paths, relative to baseDir, and returns the names of the files to upload.
+// The names are relative to baseDir and use forward slashes, as expected by the workspace.
+// Symbolic links are skipped with a warning, as well as the files in config.FilesBlacklist.
+func collectUploadFiles(baseDir string, paths []string, out io.Writer) ([]string, error) {
+	var files []string
+	for _, path := range paths {
+		root := filepath.Join(baseDir, filepath.FromSlash(path))
+		err := filepath.WalkDir(root, func(p string, d fs.DirEntry, err error) error {
+			if err != nil {
+				return err
+			}
+
+			relPath, err := filepath.Rel(baseDir, p)
+			if err != nil {
+				return err
+			}
+			name := filepath.ToSlash(relPath)
+
+			// blacklisted names are matched anywhere in the path, e.g. code/.git/config
+			if d.IsDir() {
+				if datautils.ContainsAny("/"+name+"/", config.FilesBlacklist) {
+					log.Infof("Ignoring directory %s", name)
+					return filepath.SkipDir
+				}
+				return nil
+			}
+			if d.Type()&fs.ModeSymlink != 0 {
+				displayer.DisplayMessage(
+					fmt.Sprintf("Symlink %s was not uploaded, symlinks are not supported.", name),
+					displayer.Warning,
+					false,
+					out,
+				)
+				return nil
+			}
+			if datautils.ContainsAny("/"+name, config.FilesBlacklist) {
+				log.Infof("Ignoring file %s", name)
+				return nil
+			}
+
+			files = append(files, name)
+			return nil
+		})
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil, fmt.Errorf("file or directory '%s' does not exist", path)
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	return files, nil
+}
+
+// getWorkspacePaths converts the given paths to paths relative to the current working directory.
+// Returns an error if any of the paths is outside the current working directory.
+func getWorkspacePaths(paths []string) ([]string, error) {
+	cwd, err := os.Getwd()
+	if err != nil {
+		return nil, err
+	}
+
+	var relPaths []string
+	for _, path := range paths {
+		absPath, err := filepath.Abs(path)
+		if err != nil {
+			return nil, err
+		}
+		relPath, err := filepath.Rel(cwd, absPath)
+		if err != nil {
+			return nil, err
+		}
+		if relPath == ".." || strings.HasPrefix(relPath, ".."+string(filepath.Separator)) {
+			return nil, fmt.Errorf(
+				"'%s' is outside the current working directory, only its files can be uploaded",
+				path,
+			)
+		}
+		relPaths = append(relPaths, filepath.ToSlash(relPath))
+	}
+	return relPaths, nil
+}
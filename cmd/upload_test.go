@@ -0,0 +1,176 @@
+/*
+This file is part of REANA.
+Copyright (C) 2022 CERN.
+
+REANA is free software; you can redistribute it and/or modify it
+under the terms of the MIT License; see LICENSE file for more details.
+*/
+
+package cmd
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+var uploadPathTemplate = "/api/workflows/%s/workspace"
+
+func TestUpload(t *testing.T) {
+	workflowName := "my_workflow"
+	tests := map[string]TestCmdParams{
+		"inputs from reana.yaml": {
+			serverResponses: map[string]ServerResponse{
+				fmt.Sprintf(uploadPathTemplate, workflowName): {
+					statusCode:   http.StatusOK,
+					responseFile: "upload_success.json",
+				},
+			},
+			args: []string{"-w", workflowName, "-f", "../testdata/specs/reana.yaml"},
+			expected: []string{
+				"File code/helloworld.py (22) was successfully uploaded.",
+				"File data/names.txt (10) was successfully uploaded.",
+			},
+		},
+		"human readable": {
+			serverResponses: map[string]ServerResponse{
+				fmt.Sprintf(uploadPathTemplate, workflowName): {
+					statusCode:   http.StatusOK,
+					responseFile: "upload_success.json",
+				},
+			},
+			args: []string{"-w", workflowName, "-f", "../testdata/specs/reana.yaml", "-h"},
+			expected: []string{
+				"File code/helloworld.py (22 Bytes) was successfully uploaded.",
+				"File data/names.txt (10 Bytes) was successfully uploaded.",
+			},
+		},
+		"given path": {
+			serverResponses: map[string]ServerResponse{
+				fmt.Sprintf(uploadPathTemplate, workflowName): {
+					statusCode:   http.StatusOK,
+					responseFile: "upload_success.json",
+				},
+			},
+			args:     []string{"-w", workflowName, "upload.go"},
+			expected: []string{"File upload.go ("},
+			unwanted: []string{"helloworld.py"},
+		},
+		"path outside working directory": {
+			args: []string{"-w", workflowName, "../testdata/specs/code"},
+			expected: []string{
+				"'../testdata/specs/code' is outside the current working directory",
+			},
+			wantError: true,
+		},
+		"missing path": {
+			args:      []string{"-w", workflowName, "missing.txt"},
+			expected:  []string{"file or directory 'missing.txt' does not exist"},
+			wantError: true,
+		},
+		"no inputs in reana.yaml": {
+			args: []string{"-w", workflowName, "-f", "../testdata/specs/reana_workflow_file.yaml"},
+			expected: []string{
+				"no input files or directories are specified in",
+			},
+			wantError: true,
+		},
+		"invalid workflow": {
+			serverResponses: map[string]ServerResponse{
+				fmt.Sprintf(uploadPathTemplate, "invalid"): {
+					statusCode:   http.StatusNotFound,
+					responseFile: "common_invalid_workflow.json",
+				},
+			},
+			args: []string{"-w", "invalid", "upload.go"},
+			expected: []string{
+				"REANA_WORKON is set to invalid, but that workflow does not exist.",
+			},
+			wantError: true,
+		},
+	}
+
+	for name, params := range tests {
+		t.Run(name, func(t *testing.T) {
+			params.cmd = "upload"
+			testCmdRun(t, params)
+		})
+	}
+}
+
+func TestCollectUploadFiles(t *testing.T) {
+	baseDir := t.TempDir()
+	for _, file := range []string{
+		"a.txt", "dir/b.txt", "dir/sub/c.txt", ".git/config", "dir/sub/.git/HEAD",
+	} {
+		path := filepath.Join(baseDir, filepath.FromSlash(file))
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte(file), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	err := os.Symlink(filepath.Join(baseDir, "a.txt"), filepath.Join(baseDir, "dir", "link"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := map[string]struct {
+		paths     []string
+		want      []string
+		warning   string
+		wantError string
+	}{
+		"single file": {paths: []string{"a.txt"}, want: []string{"a.txt"}},
+		"directory": {
+			paths:   []string{"dir"},
+			want:    []string{"dir/b.txt", "dir/sub/c.txt"},
+			warning: "Symlink dir/link was not uploaded",
+		},
+		"blacklisted directory": {
+			paths: []string{".git", "a.txt"},
+			want:  []string{"a.txt"},
+		},
+		"nested blacklisted directory": {
+			paths: []string{"dir/sub"},
+			want:  []string{"dir/sub/c.txt"},
+		},
+		"whole base directory": {
+			paths:   []string{"."},
+			want:    []string{"a.txt", "dir/b.txt", "dir/sub/c.txt"},
+			warning: "Symlink dir/link was not uploaded",
+		},
+		"missing file": {
+			paths:     []string{"missing.txt"},
+			wantError: "file or directory 'missing.txt' does not exist",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			out := new(bytes.Buffer)
+			got, err := collectUploadFiles(baseDir, test.paths, out)
+			if test.wantError != "" {
+				if err == nil || err.Error() != test.wantError {
+					t.Fatalf("Expected error '%s', instead got '%v'", test.wantError, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Got unexpected error '%s'", err.Error())
+			}
+			if !reflect.DeepEqual(got, test.want) {
+				t.Errorf("Expected %v, instead got %v", test.want, got)
+			}
+			if !strings.Contains(out.String(), test.warning) {
+				t.Errorf("Expected '%s' in output, instead got '%s'", test.warning, out.String())
+			}
+		})
+	}
+}
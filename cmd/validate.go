@@ -0,0 +1,158 @@
+/*
+This file is part of REANA.
+Copyright (C) 2022 CERN.
+
+REANA is free software; you can redistribute it and/or modify it
+under the terms of the MIT License; see LICENSE file for more details.
+*/
+
+package cmd
+
+import (
+	"fmt"
+	"reanahub/reana-client-go/pkg/config"
+	"reanahub/reana-client-go/pkg/displayer"
+	"reanahub/reana-client-go/pkg/specification"
+	"strings"
+
+	"github.com/spf13/cobra"
+)
+
+const validateDesc = `
+Validate workflow specification file.
+
+The ` + "``validate``" + ` command allows to check syntax and validate the reana.yaml
+workflow specification file, together with the workflow file it references.
+The workflow specification is checked according to its engine, and warnings
+are given about undeclared or unused input parameters and missing input
+files. The problems found are reported with the file and line where they
+occur, so that broken specifications can be fixed before being sent to the
+server.
+
+The container images of the workflow steps are checked as well, and the ones
+without a pinned tag (e.g. missing or latest) are flagged. The registries the
+images can be pulled from can be restricted with ` + "``--allowed-registry``" + `.
+
+Examples:
+
+$ reana-client validate
+
+$ reana-client validate -f myreana.yaml
+
+$ reana-client validate --allowed-registry docker.io,gitlab-registry.cern.ch
+`
+
+type validateOptions struct {
+	file              string
+	allowedRegistries []string
+}
+
+// newValidateCmd creates a command to validate a workflow specification file.
+func newValidateCmd() *cobra.Command {
+	o := &validateOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "validate",
+		Short: "Validate workflow specification file.",
+		Long:  validateDesc,
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return o.run(cmd)
+		},
+	}
+
+	f := cmd.Flags()
+	f.StringVarP(
+		&o.file,
+		"file",
+		"f",
+		"reana.yaml",
+		"REANA specification file describing the workflow to execute.",
+	)
+	f.StringSliceVar(
+		&o.allowedRegistries,
+		"allowed-registry",
+		[]string{},
+		`Registries the container images can be pulled from, all of them by default.
+E.g. --allowed-registry docker.io --allowed-registry gitlab-registry.cern.ch`,
+	)
+
+	return cmd
+}
+
+func (o *validateOptions) run(cmd *cobra.Command) error {
+	displayer.DisplayMessage(
+		fmt.Sprintf("Verifying REANA specification file... %s", o.file),
+		displayer.Info,
+		false,
+		cmd.OutOrStdout(),
+	)
+
+	_, validationErrors := specification.Validate(o.file)
+	for _, err := range validationErrors {
+		messageType := displayer.Error
+		if err.Warning {
+			messageType = displayer.Warning
+		}
+		displayer.DisplayMessage(err.Error(), messageType, true, cmd.OutOrStdout())
+	}
+	if validationErrors.HasErrors() {
+		return config.EmptyError
+	}
+
+	displayer.DisplayMessage(
+		"Valid REANA specification file.",
+		displayer.Success,
+		true,
+		cmd.OutOrStdout(),
+	)
+
+	return o.validateEnvironments(cmd)
+}
+
+// validateEnvironments displays the container images of the workflow steps in a table, flagging the
+// ones that are not pinned or that come from registries which are not allowed.
+func (o *validateOptions) validateEnvironments(cmd *cobra.Command) error {
+	displayer.DisplayMessage(
+		"Verifying environments in REANA specification file...",
+		displayer.Info,
+		false,
+		cmd.OutOrStdout(),
+	)
+
+	environments, err := specification.CheckEnvironments(o.file, o.allowedRegistries)
+	if err != nil {
+		return err
+	}
+	if len(environments) == 0 {
+		displayer.DisplayMessage(
+			"No environments found in the workflow specification.",
+			displayer.Info,
+			true,
+			cmd.OutOrStdout(),
+		)
+		return nil
+	}
+
+	header := []string{"image", "step", "location", "status"}
+	var rows [][]string
+	flagged := 0
+	for _, env := range environments {
+		status := "ok"
+		if len(env.Problems) > 0 {
+			status = strings.Join(env.Problems, "; ")
+			flagged++
+		}
+		rows = append(rows, []string{env.Image, env.Step, env.Location(), status})
+	}
+	displayer.DisplayTable(header, rows, cmd.OutOrStdout())
+
+	if flagged > 0 {
+		return fmt.Errorf(
+			"%d out of %d environments are not pinned or come from registries not allowed",
+			flagged,
+			len(environments),
+		)
+	}
+	return nil
+}
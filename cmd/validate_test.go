@@ -0,0 +1,108 @@
+/*
+This file is part of REANA.
+Copyright (C) 2022 CERN.
+
+REANA is free software; you can redistribute it and/or modify it
+under the terms of the MIT License; see LICENSE file for more details.
+*/
+
+package cmd
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestValidate(t *testing.T) {
+	tests := map[string]struct {
+		args      []string
+		expected  []string
+		wantError bool
+	}{
+		"valid specification": {
+			args: []string{"-f", "../testdata/specs/reana.yaml"},
+			expected: []string{
+				"Verifying REANA specification file... ../testdata/specs/reana.yaml",
+				"Valid REANA specification file.",
+			},
+		},
+		"valid workflow file": {
+			args:     []string{"-f", "../testdata/specs/reana_workflow_file.yaml"},
+			expected: []string{"Valid REANA specification file."},
+		},
+		"warnings": {
+			args: []string{"-f", "../testdata/specs/reana_warnings.yaml"},
+			expected: []string{
+				"WARNING: ",
+				"../testdata/specs/reana_warnings.yaml:4: " +
+					"file '../testdata/specs/code/missing.py' does not exist",
+				"Valid REANA specification file.",
+			},
+		},
+		"pinned environments": {
+			args: []string{"-f", "../testdata/specs/reana.yaml"},
+			expected: []string{
+				"Verifying environments in REANA specification file...",
+				"docker.io/library/python:3.10-bookworm   helloworld   " +
+					"../testdata/specs/reana.yaml:15   ok",
+			},
+		},
+		"unpinned environment": {
+			args: []string{"-f", "../testdata/specs/reana_unpinned.yaml"},
+			expected: []string{
+				"Valid REANA specification file.",
+				"python:latest   helloworld   " +
+					"../testdata/specs/reana_unpinned.yaml:15   image uses the latest tag",
+			},
+			wantError: true,
+		},
+		"disallowed registry": {
+			args: []string{
+				"-f", "../testdata/specs/reana_warnings.yaml",
+				"--allowed-registry", "gitlab-registry.cern.ch",
+			},
+			expected: []string{
+				"registry 'docker.io' is not part of the allowed registries",
+			},
+			wantError: true,
+		},
+		"syntax error": {
+			args: []string{"-f", "../testdata/specs/reana_invalid.yaml"},
+			expected: []string{
+				"../testdata/specs/reana_invalid.yaml:3: did not find expected ',' or ']'",
+			},
+			wantError: true,
+		},
+		"invalid structure": {
+			args: []string{"-f", "../testdata/specs/reana_invalid_structure.yaml"},
+			expected: []string{
+				"reana_invalid_structure.yaml:2: unknown property 'input'",
+				"reana_invalid_structure.yaml:6: invalid value for 'workflow.type': 'nextflow'",
+			},
+			wantError: true,
+		},
+		"missing file": {
+			args:      []string{"-f", "../testdata/specs/missing.yaml"},
+			expected:  []string{"no such file or directory"},
+			wantError: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			args := append([]string{"validate"}, test.args...)
+			output, err := ExecuteCommand(NewRootCmd(), args...)
+			if !test.wantError && err != nil {
+				t.Fatalf("Got unexpected error '%s'", err.Error())
+			}
+			if test.wantError && err == nil {
+				t.Fatalf("Expected error, instead got '%s'", output)
+			}
+			for _, expected := range test.expected {
+				if !strings.Contains(output, expected) {
+					t.Errorf("Expected '%s' in output, instead got '%s'", expected, output)
+				}
+			}
+		})
+	}
+}
@@ -9,28 +9,212 @@ under the terms of the MIT License; see LICENSE file for more details.
 package cmd
 
 import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"reanahub/reana-client-go/client"
+	"reanahub/reana-client-go/client/operations"
+	"reanahub/reana-client-go/pkg/compatibility"
+	"reanahub/reana-client-go/pkg/contexts"
+	"reanahub/reana-client-go/pkg/displayer"
+	"reanahub/reana-client-go/pkg/validator"
+	"time"
+
+	log "github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
 )
 
 const version = "v0.0.0-alpha.1"
 
+// versionCacheFile name of the file caching the versions of the servers, stored next to the
+// configuration file.
+const versionCacheFile = "versions.json"
+
+// versionCheckTimeout maximum duration of the automatic check of the version of the server.
+const versionCheckTimeout = 5 * time.Second
+
 const versionDesc = `
 Show version.
 
 The ` + "``version``" + ` command shows REANA client version.
+
+With ` + "``--server``" + `, the version of the REANA server is also shown, and a warning is
+displayed if it is not supported by the client. This check also runs automatically,
+at most once a day for each server, for the commands interacting with the REANA
+server, unless the REANA_SKIP_VERSION_CHECK environment variable is set to true.
+The warning is then only displayed once a day.
+
+Examples:
+
+$ reana-client version
+
+$ reana-client version --server
 `
 
+type versionOptions struct {
+	token  string
+	server bool
+}
+
 // newVersionCmd creates a command to show the version of the client.
 func newVersionCmd() *cobra.Command {
+	o := &versionOptions{}
+
 	cmd := &cobra.Command{
 		Use:   "version",
 		Short: "Show version.",
 		Long:  versionDesc,
 		Args:  cobra.NoArgs,
-		Run: func(cmd *cobra.Command, args []string) {
-			cmd.Println(version)
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return o.run(cmd)
 		},
 	}
 
+	f := cmd.Flags()
+	f.StringVarP(&o.token, "access-token", "t", "", "Access token of the current user.")
+	f.BoolVar(&o.server, "server", false, "Show the version of the REANA server too.")
+	// the access token is only needed with --server
+	if err := f.SetAnnotation("access-token", "properties", []string{"optional"}); err != nil {
+		log.Debugf("Failed to set access-token annotation: %s", err.Error())
+	}
+	if err := f.SetAnnotation("access-token", neededByAnnotation, []string{"server"}); err != nil {
+		log.Debugf("Failed to set access-token annotation: %s", err.Error())
+	}
+
 	return cmd
 }
+
+func (o *versionOptions) run(cmd *cobra.Command) error {
+	if !o.server {
+		cmd.Println(version)
+		return nil
+	}
+
+	if err := bindViperToCmdFlag(cmd.Flag("access-token")); err != nil {
+		return err
+	}
+	if err := validator.ValidateAccessToken(o.token); err != nil {
+		return err
+	}
+	serverURL := viper.GetString("server-url")
+	if err := validator.ValidateServerURL(serverURL); err != nil {
+		return err
+	}
+
+	serverVersion, err := requestServerVersion(
+		cmd.Context(), o.token, viper.GetInt("max-retries"),
+	)
+	if err != nil {
+		return err
+	}
+	if path, err := versionCachePath(); err == nil {
+		if cache, err := compatibility.LoadCache(path); err == nil {
+			cacheServerVersion(cache, path, serverURL, serverVersion, false)
+		}
+	}
+	cmd.Printf("Client version: %s\n", version)
+	cmd.Printf("Server version: %s\n", serverVersion)
+	displayCompatibility(cmd, serverVersion)
+	return nil
+}
+
+// checkServerVersion warns when the version of the REANA server is not supported by the client.
+// The version of the server is requested at most once a day, or once an hour after a failure, and
+// the warning is only displayed at that time. The request is not retried and times out after
+// versionCheckTimeout, since failures are only logged and must not delay the command.
+func checkServerVersion(cmd *cobra.Command, token, serverURL string) {
+	path, err := versionCachePath()
+	if err != nil {
+		log.Debugf("Cannot check the version of the server: %s", err.Error())
+		return
+	}
+	cache, err := compatibility.LoadCache(path)
+	if err != nil {
+		log.Debugf("Cannot check the version of the server: %s", err.Error())
+		return
+	}
+	if _, ok := cache.Recent(serverURL, time.Now()); ok {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(cmd.Context(), versionCheckTimeout)
+	defer cancel()
+	serverVersion, err := requestServerVersion(ctx, token, 0)
+	cacheServerVersion(cache, path, serverURL, serverVersion, err != nil)
+	if err != nil {
+		log.Debugf("Cannot check the version of the server: %s", err.Error())
+		return
+	}
+	displayCompatibility(cmd, serverVersion)
+}
+
+// requestServerVersion requests the version of the REANA server, retrying maxRetries times.
+func requestServerVersion(ctx context.Context, token string, maxRetries int) (string, error) {
+	youParams := operations.NewGetYouParamsWithContext(ctx)
+	youParams.SetAccessToken(&token)
+
+	api, err := client.ApiClientWithRetries(maxRetries)
+	if err != nil {
+		return "", err
+	}
+	youResp, err := api.Operations.GetYou(youParams)
+	if err != nil {
+		return "", err
+	}
+	return youResp.Payload.ReanaServerVersion, nil
+}
+
+// cacheServerVersion saves the version of the server in the cache, or that its request failed.
+func cacheServerVersion(
+	cache compatibility.Cache,
+	path, serverURL, serverVersion string,
+	failed bool,
+) {
+	cache[serverURL] = compatibility.CacheEntry{
+		CheckedAt:     time.Now(),
+		ServerVersion: serverVersion,
+		Failed:        failed,
+	}
+	if err := cache.Save(path); err != nil {
+		log.Debugf("Cannot cache the version of the server: %s", err.Error())
+	}
+}
+
+// displayCompatibility warns when the version of the REANA server is out of the range supported
+// by the client.
+func displayCompatibility(cmd *cobra.Command, serverVersion string) {
+	status, supported, err := compatibility.Check(version, serverVersion)
+	if err != nil {
+		log.Debugf("Cannot check the version of the server: %s", err.Error())
+		return
+	}
+
+	var message string
+	switch status {
+	case compatibility.ServerTooOld:
+		message = fmt.Sprintf(
+			"REANA server version %s is older than the versions supported by reana-client %s "+
+				"(from %s to %s excluded), some commands may not work.",
+			serverVersion, version, supported.Min, supported.Max,
+		)
+	case compatibility.ServerTooNew:
+		message = fmt.Sprintf(
+			"REANA server version %s is newer than the versions supported by reana-client %s "+
+				"(from %s to %s excluded), please upgrade reana-client.",
+			serverVersion, version, supported.Min, supported.Max,
+		)
+	default:
+		return
+	}
+	displayer.DisplayMessage(message, displayer.Warning, false, cmd.ErrOrStderr())
+}
+
+// versionCachePath returns the path of the file caching the versions of the servers.
+func versionCachePath() (string, error) {
+	path, err := contexts.DefaultPath()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(filepath.Dir(path), versionCacheFile), nil
+}
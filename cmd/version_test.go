@@ -9,8 +9,15 @@ under the terms of the MIT License; see LICENSE file for more details.
 package cmd
 
 import (
+	"bytes"
+	"context"
+	"net/http"
+	"reanahub/reana-client-go/pkg/compatibility"
 	"strings"
 	"testing"
+	"time"
+
+	"github.com/spf13/viper"
 )
 
 func TestVersion(t *testing.T) {
@@ -21,3 +28,143 @@ func TestVersion(t *testing.T) {
 		t.Fatalf("Expected: \"%s\", got: \"%s\"", version, out)
 	}
 }
+
+func TestVersionServer(t *testing.T) {
+	tests := map[string]TestCmdParams{
+		"compatible": {
+			serverResponses: map[string]ServerResponse{
+				youPath: {statusCode: http.StatusOK, responseFile: "login_active.json"},
+			},
+			expected: []string{"Client version: " + version, "Server version: 0.9.0"},
+			unwanted: []string{"WARNING"},
+		},
+		"server too old": {
+			serverResponses: map[string]ServerResponse{
+				youPath: {statusCode: http.StatusOK, responseFile: "version_server_old.json"},
+			},
+			expected: []string{
+				"Server version: 0.8.1",
+				"REANA server version 0.8.1 is older than the versions supported",
+			},
+		},
+		"server too new": {
+			serverResponses: map[string]ServerResponse{
+				youPath: {statusCode: http.StatusOK, responseFile: "version_server_new.json"},
+			},
+			expected: []string{
+				"Server version: 0.10.0",
+				"REANA server version 0.10.0 is newer than the versions supported",
+				"please upgrade reana-client.",
+			},
+		},
+		"invalid token": {
+			serverResponses: map[string]ServerResponse{
+				youPath: {
+					statusCode:   http.StatusForbidden,
+					responseFile: "login_invalid_token.json",
+				},
+			},
+			expected:  []string{"Token not valid."},
+			wantError: true,
+		},
+	}
+
+	for name, params := range tests {
+		t.Run(name, func(t *testing.T) {
+			params.cmd = "version"
+			params.args = []string{"--server"}
+			testCmdRun(t, params)
+		})
+	}
+}
+
+func TestCheckServerVersion(t *testing.T) {
+	t.Run("automatic check", func(t *testing.T) {
+		viper.Set("skip-version-check", false)
+		testCmdRun(t, TestCmdParams{
+			cmd: "info",
+			serverResponses: map[string]ServerResponse{
+				infoServerPath: {statusCode: http.StatusOK, responseFile: "info_small.json"},
+				youPath: {
+					statusCode:   http.StatusOK,
+					responseFile: "version_server_old.json",
+				},
+			},
+			expected: []string{"REANA server version 0.8.1 is older"},
+		})
+
+		path, err := versionCachePath()
+		if err != nil {
+			t.Fatal(err)
+		}
+		cache, err := compatibility.LoadCache(path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		serverVersion, ok := cache.Recent(viper.GetString("server-url"), time.Now())
+		if !ok || serverVersion != "0.8.1" {
+			t.Errorf("Expected the version of the server to be cached, instead got %v", cache)
+		}
+	})
+
+	t.Run("cached version", func(t *testing.T) {
+		writeTestConfig(t, nil)
+		cachePath, err := versionCachePath()
+		if err != nil {
+			t.Fatal(err)
+		}
+		serverURL := "https://127.0.0.1:1" // not reachable, the cached version must be used
+		cache := compatibility.Cache{
+			serverURL: {CheckedAt: time.Now().Add(-time.Hour), ServerVersion: "0.10.1"},
+		}
+		if err := cache.Save(cachePath); err != nil {
+			t.Fatal(err)
+		}
+
+		cmd := newInfoCmd()
+		out := new(bytes.Buffer)
+		cmd.SetErr(out)
+		checkServerVersion(cmd, "1234", serverURL)
+		// the warning was displayed when the version was requested
+		if out.Len() != 0 {
+			t.Errorf("Expected no warning from the cached version, instead got '%s'", out.String())
+		}
+	})
+
+	t.Run("unreachable server", func(t *testing.T) {
+		writeTestConfig(t, nil)
+		viper.Set("max-retries", 3)
+		t.Cleanup(viper.Reset)
+		serverURL := "https://127.0.0.1:1"
+		viper.Set("server-url", serverURL)
+
+		cmd := newInfoCmd()
+		cmd.SetContext(context.Background())
+		out := new(bytes.Buffer)
+		cmd.SetErr(out)
+		start := time.Now()
+		checkServerVersion(cmd, "1234", serverURL)
+		// the request is not retried, which would take more than a second with the backoff
+		if elapsed := time.Since(start); elapsed > time.Second {
+			t.Errorf("Expected the check to fail fast, instead it took %s", elapsed)
+		}
+		if out.Len() != 0 {
+			t.Errorf("Expected no output, instead got '%s'", out.String())
+		}
+
+		cachePath, err := versionCachePath()
+		if err != nil {
+			t.Fatal(err)
+		}
+		cache, err := compatibility.LoadCache(cachePath)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if entry := cache[serverURL]; !entry.Failed {
+			t.Errorf("Expected the failure to be cached, instead got %v", cache)
+		}
+		if _, ok := cache.Recent(serverURL, time.Now()); !ok {
+			t.Errorf("Expected the failure to prevent new requests, instead got %v", cache)
+		}
+	})
+}
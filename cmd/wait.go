@@ -0,0 +1,224 @@
+/*
+This file is part of REANA.
+Copyright (C) 2022 CERN.
+
+REANA is free software; you can redistribute it and/or modify it
+under the terms of the MIT License; see LICENSE file for more details.
+*/
+
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reanahub/reana-client-go/pkg/config"
+	"reanahub/reana-client-go/pkg/displayer"
+	"reanahub/reana-client-go/pkg/errorhandler"
+	"reanahub/reana-client-go/pkg/validator"
+	"reanahub/reana-client-go/pkg/workflows"
+	"strings"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+	"golang.org/x/exp/slices"
+)
+
+const waitDesc = `
+Wait for workflows to end.
+
+The ` + "``wait``" + ` command waits until the given workflows have finished, failed,
+been stopped or deleted, and exits with a code depending on their status:
+
+  0    finished
+  2    failed
+  3    stopped
+  4    deleted
+  124  timeout
+
+Other errors exit with code 1. The workflows are given as arguments, or with
+the environment variable REANA_WORKON or the ` + "``--workflow``" + ` flag. When several
+workflows are given, the command waits for all of them by default, and exits
+with the code of the first one that did not finish. With ` + "``--any``" + `, it stops
+as soon as one of them ends, exiting with its code.
+
+Examples:
+
+  $ reana-client wait -w myanalysis.42
+
+  $ reana-client wait myanalysis.42 myanalysis.43 --timeout 2h
+
+  $ reana-client wait myanalysis.42 myanalysis.43 --any
+`
+
+// workflowExitCodes exit codes of the commands waiting for a workflow, e.g. wait or logs --follow,
+// according to the status of the workflow once it has ended.
+var workflowExitCodes = map[string]int{
+	"finished": 0,
+	"failed":   2,
+	"stopped":  3,
+	"deleted":  4,
+}
+
+// waitTimeoutExitCode exit code of the wait command when the workflows did not end in time, as
+// used by the timeout utility.
+const waitTimeoutExitCode = 124
+
+type waitOptions struct {
+	token    string
+	workflow string
+	timeout  time.Duration
+	any      bool
+	all      bool
+}
+
+// newWaitCmd creates a command to wait for workflows to end.
+func newWaitCmd() *cobra.Command {
+	o := &waitOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "wait [WORKFLOW]...",
+		Short: "Wait for workflows to end.",
+		Long:  waitDesc,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return o.run(cmd, args)
+		},
+	}
+
+	f := cmd.Flags()
+	f.StringVarP(&o.token, "access-token", "t", "", "Access token of the current user.")
+	f.StringVarP(
+		&o.workflow,
+		"workflow",
+		"w", "",
+		`Name or UUID of the workflow, when no workflows are given as arguments.
+Overrides value of REANA_WORKON environment variable.`,
+	)
+	f.DurationVar(
+		&o.timeout,
+		"timeout",
+		0,
+		`Maximum time to wait for the workflows (e.g. 30m, 2h), 0 waits indefinitely.
+The timeout of each request can be set with REANA_TIMEOUT.`,
+	)
+	f.BoolVar(&o.any, "any", false, "Stop waiting as soon as one of the workflows ends.")
+	f.BoolVar(&o.all, "all", false, "Wait for all the workflows to end (default).")
+	cmd.MarkFlagsMutuallyExclusive("any", "all")
+
+	err := f.SetAnnotation("workflow", "properties", []string{"optional"})
+	if err != nil {
+		log.Debugf("Failed to set workflow annotation: %s", err.Error())
+	}
+	return cmd
+}
+
+func (o *waitOptions) run(cmd *cobra.Command, args []string) error {
+	names := args
+	if len(names) == 0 {
+		workflowFlag := cmd.Flags().Lookup("workflow")
+		if err := bindViperToCmdFlag(workflowFlag); err != nil {
+			return err
+		}
+		if err := validator.ValidateWorkflow(o.workflow); err != nil {
+			return err
+		}
+		names = []string{o.workflow}
+	}
+
+	ctx := cmd.Context()
+	if o.timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, o.timeout)
+		defer cancel()
+	}
+
+	statuses := map[string]string{}
+	for {
+		for _, name := range names {
+			if slices.Contains(config.EndedRunStatuses, statuses[name]) {
+				continue
+			}
+			payload, err := workflows.GetStatus(ctx, o.token, name)
+			if err != nil {
+				if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+					return o.timeoutError(names, statuses)
+				}
+				return err
+			}
+			statuses[name] = payload.Status
+			if err := displayEndedStatus(cmd, name, payload.Status); err != nil {
+				return err
+			}
+		}
+
+		if ended, exitCode := getWaitOutcome(names, statuses, o.any); ended {
+			if exitCode != 0 {
+				return &errorhandler.ExitError{Code: exitCode, Err: config.EmptyError}
+			}
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+				return o.timeoutError(names, statuses)
+			}
+			return ctx.Err()
+		case <-time.After(time.Duration(config.CheckInterval) * time.Second):
+		}
+	}
+}
+
+// timeoutError returns the error reporting the workflows that did not end before the timeout.
+func (o *waitOptions) timeoutError(names []string, statuses map[string]string) error {
+	var pending []string
+	for _, name := range names {
+		if !slices.Contains(config.EndedRunStatuses, statuses[name]) {
+			pending = append(pending, name)
+		}
+	}
+	return &errorhandler.ExitError{
+		Code: waitTimeoutExitCode,
+		Err: fmt.Errorf(
+			"timed out after %s waiting for %s", o.timeout, strings.Join(pending, ", "),
+		),
+	}
+}
+
+// displayEndedStatus displays the status of a workflow once it has ended.
+func displayEndedStatus(cmd *cobra.Command, name, status string) error {
+	if !slices.Contains(config.EndedRunStatuses, status) {
+		return nil
+	}
+	msg, err := workflows.StatusChangeMessage(name, status)
+	if err != nil {
+		return err
+	}
+	messageType := displayer.Error
+	if status == "finished" {
+		messageType = displayer.Success
+	}
+	displayer.DisplayMessage(msg, messageType, false, cmd.OutOrStdout())
+	return nil
+}
+
+// getWaitOutcome checks if the wait is over, according to the statuses of the workflows and
+// whether to wait for any of them, and returns the exit code of the command in this case.
+func getWaitOutcome(names []string, statuses map[string]string, any bool) (bool, int) {
+	exitCode := 0
+	for _, name := range names {
+		status := statuses[name]
+		ended := slices.Contains(config.EndedRunStatuses, status)
+		if any && ended {
+			return true, workflowExitCodes[status]
+		}
+		if !any && !ended {
+			return false, 0
+		}
+		if exitCode == 0 {
+			exitCode = workflowExitCodes[status]
+		}
+	}
+	return !any, exitCode
+}
@@ -0,0 +1,146 @@
+/*
+This file is part of REANA.
+Copyright (C) 2022 CERN.
+
+REANA is free software; you can redistribute it and/or modify it
+under the terms of the MIT License; see LICENSE file for more details.
+*/
+
+package cmd
+
+import (
+	"fmt"
+	"net/http"
+	"reanahub/reana-client-go/pkg/config"
+	"testing"
+)
+
+var waitStatusPathTemplate = "/api/workflows/%s/status"
+
+func TestWait(t *testing.T) {
+	// Deactivate the sleep between the requests
+	oldInterval := config.CheckInterval
+	config.CheckInterval = 0
+	t.Cleanup(func() {
+		config.CheckInterval = oldInterval
+	})
+
+	finishedResponse := ServerResponse{
+		statusCode: http.StatusOK, responseFile: "status_finished.json",
+	}
+	stoppedResponse := ServerResponse{
+		statusCode: http.StatusOK, responseFile: "status_stopped.json",
+	}
+	runningResponse := ServerResponse{
+		statusCode: http.StatusOK, responseFile: "status_running.json",
+	}
+
+	tests := map[string]TestCmdParams{
+		"finished": {
+			serverResponses: map[string]ServerResponse{
+				fmt.Sprintf(waitStatusPathTemplate, "wf1"): finishedResponse,
+			},
+			args:     []string{"-w", "wf1"},
+			expected: []string{"wf1 has finished"},
+		},
+		"stopped": {
+			serverResponses: map[string]ServerResponse{
+				fmt.Sprintf(waitStatusPathTemplate, "wf1"): stoppedResponse,
+			},
+			args:      []string{"wf1"},
+			expected:  []string{"wf1 has been stopped"},
+			wantError: true,
+		},
+		"all workflows": {
+			serverResponses: map[string]ServerResponse{
+				fmt.Sprintf(waitStatusPathTemplate, "wf1"): finishedResponse,
+				fmt.Sprintf(waitStatusPathTemplate, "wf2"): stoppedResponse,
+			},
+			args:      []string{"wf1", "wf2", "--all"},
+			expected:  []string{"wf1 has finished", "wf2 has been stopped"},
+			wantError: true,
+		},
+		"any workflow": {
+			serverResponses: map[string]ServerResponse{
+				fmt.Sprintf(waitStatusPathTemplate, "wf1"): runningResponse,
+				fmt.Sprintf(waitStatusPathTemplate, "wf2"): finishedResponse,
+			},
+			args:     []string{"wf1", "wf2", "--any"},
+			expected: []string{"wf2 has finished"},
+			unwanted: []string{"wf1"},
+		},
+		"timeout": {
+			serverResponses: map[string]ServerResponse{
+				fmt.Sprintf(waitStatusPathTemplate, "wf1"): runningResponse,
+			},
+			args:      []string{"wf1", "--timeout", "10ms"},
+			expected:  []string{"timed out after 10ms waiting for wf1"},
+			wantError: true,
+		},
+		"any and all": {
+			args:      []string{"wf1", "wf2", "--any", "--all"},
+			expected:  []string{"[all any] were all set"},
+			wantError: true,
+		},
+		"no workflow": {
+			args:      []string{},
+			expected:  []string{"workflow name must be provided"},
+			wantError: true,
+		},
+	}
+
+	for name, params := range tests {
+		t.Run(name, func(t *testing.T) {
+			params.cmd = "wait"
+			testCmdRun(t, params)
+		})
+	}
+}
+
+func TestGetWaitOutcome(t *testing.T) {
+	names := []string{"wf1", "wf2"}
+	tests := map[string]struct {
+		statuses map[string]string
+		any      bool
+		ended    bool
+		exitCode int
+	}{
+		"all running": {
+			statuses: map[string]string{"wf1": "running", "wf2": "queued"},
+		},
+		"one ended": {
+			statuses: map[string]string{"wf1": "failed", "wf2": "running"},
+		},
+		"all finished": {
+			statuses: map[string]string{"wf1": "finished", "wf2": "finished"},
+			ended:    true,
+		},
+		"all ended": {
+			statuses: map[string]string{"wf1": "finished", "wf2": "deleted"},
+			ended:    true, exitCode: 4,
+		},
+		"first not finished": {
+			statuses: map[string]string{"wf1": "failed", "wf2": "stopped"},
+			ended:    true, exitCode: 2,
+		},
+		"any running": {
+			statuses: map[string]string{"wf1": "running", "wf2": "pending"},
+			any:      true,
+		},
+		"any ended": {
+			statuses: map[string]string{"wf1": "running", "wf2": "stopped"},
+			any:      true, ended: true, exitCode: 3,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			ended, exitCode := getWaitOutcome(names, test.statuses, test.any)
+			if ended != test.ended || exitCode != test.exitCode {
+				t.Errorf(
+					"Expected (%t, %d), got (%t, %d)", test.ended, test.exitCode, ended, exitCode,
+				)
+			}
+		})
+	}
+}
@@ -0,0 +1,147 @@
+/*
+This file is part of REANA.
+Copyright (C) 2022 CERN.
+
+REANA is free software; you can redistribute it and/or modify it
+under the terms of the MIT License; see LICENSE file for more details.
+*/
+
+package cmd
+
+import (
+	"fmt"
+	"reanahub/reana-client-go/client"
+	"reanahub/reana-client-go/client/operations"
+	"reanahub/reana-client-go/pkg/displayer"
+	"reanahub/reana-client-go/pkg/formatter"
+	"sort"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+const whoamiDesc = `
+Show the current user.
+
+The ` + "``whoami``" + ` command shows the email of the user owning the access token,
+the status of the token and when it was requested, the version of the REANA
+server and a summary of the quota usage. It allows to confirm that an account
+is fully activated.
+
+Examples:
+
+$ reana-client whoami
+
+$ reana-client whoami --json
+`
+
+type whoamiOptions struct {
+	token      string
+	jsonOutput bool
+}
+
+// newWhoamiCmd creates a command to show the current user.
+func newWhoamiCmd() *cobra.Command {
+	o := &whoamiOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "whoami",
+		Short: "Show the current user.",
+		Long:  whoamiDesc,
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return o.run(cmd)
+		},
+	}
+
+	f := cmd.Flags()
+	f.StringVarP(&o.token, "access-token", "t", "", "Access token of the current user.")
+	f.BoolVarP(&o.jsonOutput, "json", "", false, "Get output in JSON format.")
+
+	return cmd
+}
+
+func (o *whoamiOptions) run(cmd *cobra.Command) error {
+	youParams := operations.NewGetYouParamsWithContext(cmd.Context())
+	youParams.SetAccessToken(&o.token)
+
+	api, err := client.ApiClient()
+	if err != nil {
+		return err
+	}
+	youResp, err := api.Operations.GetYou(youParams)
+	if err != nil {
+		return err
+	}
+
+	p := youResp.Payload
+	if p.ReanaToken != nil && !viper.GetBool("show-token") {
+		token := *p.ReanaToken
+		token.Value = formatter.RedactToken(token.Value)
+		p.ReanaToken = &token
+	}
+	if o.jsonOutput {
+		return displayer.DisplayJsonOutput(p, cmd.OutOrStdout())
+	}
+
+	status, requestedAt := "None", "None"
+	if p.ReanaToken != nil {
+		status = valueOrNone(p.ReanaToken.Status)
+		requestedAt = valueOrNone(p.ReanaToken.RequestedAt)
+	}
+	quota, err := quotaSummary(p.Quota)
+	if err != nil {
+		return err
+	}
+
+	cmd.Printf("Email: %s\n", valueOrNone(p.Email))
+	cmd.Printf("Token status: %s\n", status)
+	cmd.Printf("Token requested at: %s\n", requestedAt)
+	cmd.Printf("Server version: %s\n", valueOrNone(p.ReanaServerVersion))
+	cmd.Printf("Quota: %s\n", quota)
+	return nil
+}
+
+// quotaSummary summarises the usage of all the quota resources in one line,
+// e.g. "cpu 1m 5s out of 10m 50s used (10%), disk 2 MiB used".
+func quotaSummary(quota *operations.GetYouOKBodyQuota) (string, error) {
+	if quota == nil {
+		return "None", nil
+	}
+	resources, err := parseQuotaInfo(quota)
+	if err != nil {
+		return "", err
+	}
+
+	var names []string
+	for name := range resources {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var summaries []string
+	for _, name := range names {
+		usage, limit := resources[name].Stats["usage"], resources[name].Stats["limit"]
+		summary := fmt.Sprintf("%s %s used", name, usage.HumanReadable)
+		if limit.Raw > 0 {
+			summary = fmt.Sprintf(
+				"%s %s out of %s used (%.0f%%)",
+				name, usage.HumanReadable, limit.HumanReadable, (usage.Raw/limit.Raw)*100,
+			)
+		}
+		summaries = append(summaries, summary)
+	}
+	if len(summaries) == 0 {
+		return "None", nil
+	}
+	return strings.Join(summaries, ", "), nil
+}
+
+// valueOrNone returns the value, or "None" when it is empty.
+func valueOrNone(value string) string {
+	if value == "" {
+		return "None"
+	}
+	return value
+}
@@ -0,0 +1,72 @@
+/*
+This file is part of REANA.
+Copyright (C) 2022 CERN.
+
+REANA is free software; you can redistribute it and/or modify it
+under the terms of the MIT License; see LICENSE file for more details.
+*/
+
+package cmd
+
+import (
+	"net/http"
+	"testing"
+)
+
+func TestWhoami(t *testing.T) {
+	tests := map[string]TestCmdParams{
+		"default": {
+			serverResponses: map[string]ServerResponse{
+				youPath: {statusCode: http.StatusOK, responseFile: "whoami.json"},
+			},
+			expected: []string{
+				"Email: john.doe@example.org",
+				"Token status: active",
+				"Token requested at: 2022-08-01T12:00:00",
+				"Server version: 0.9.0",
+				"Quota: cpu 1m 5s out of 10m 50s used (10%), disk 2 MiB used",
+			},
+		},
+		"no quota": {
+			serverResponses: map[string]ServerResponse{
+				youPath: {statusCode: http.StatusOK, responseFile: "login_active.json"},
+			},
+			expected: []string{"Email: john.doe@example.org", "Quota: None"},
+		},
+		"json": {
+			serverResponses: map[string]ServerResponse{
+				youPath: {statusCode: http.StatusOK, responseFile: "whoami.json"},
+			},
+			args: []string{"--json"},
+			expected: []string{
+				"\"email\": \"john.doe@example.org\"", "\"status\": \"active\"",
+				"\"value\": \"REDACTED\"", "\"human_readable\": \"10m 50s\"",
+			},
+			unwanted: []string{"1234"},
+		},
+		"json showing token": {
+			serverResponses: map[string]ServerResponse{
+				youPath: {statusCode: http.StatusOK, responseFile: "whoami.json"},
+			},
+			args:     []string{"--json", "--show-token"},
+			expected: []string{"\"value\": \"1234\""},
+		},
+		"invalid token": {
+			serverResponses: map[string]ServerResponse{
+				youPath: {
+					statusCode:   http.StatusForbidden,
+					responseFile: "login_invalid_token.json",
+				},
+			},
+			expected:  []string{"Token not valid."},
+			wantError: true,
+		},
+	}
+
+	for name, params := range tests {
+		t.Run(name, func(t *testing.T) {
+			params.cmd = "whoami"
+			testCmdRun(t, params)
+		})
+	}
+}
@@ -14,25 +14,44 @@ Use --help for more information.
 package main
 
 import (
+	"context"
+	"errors"
 	"os"
+	"os/signal"
 	"reanahub/reana-client-go/cmd"
 	"reanahub/reana-client-go/pkg/config"
 	"reanahub/reana-client-go/pkg/displayer"
 	"reanahub/reana-client-go/pkg/errorhandler"
+	"syscall"
 
 	log "github.com/sirupsen/logrus"
 )
 
+// interruptedExitCode exit code used when the client is interrupted, as done by shells on SIGINT.
+const interruptedExitCode = 130
+
 func main() {
+	// cancel the requests and loops of the commands when the user interrupts the client
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	go func() {
+		// restore the default behaviour, so that a second interrupt terminates immediately
+		<-ctx.Done()
+		stop()
+	}()
+
 	rootCmd := cmd.NewRootCmd()
-	err := rootCmd.Execute()
+	err := rootCmd.ExecuteContext(ctx)
 
 	if err != nil {
 		log.Debug(err)
+		exitCode := errorhandler.ExitCode(err)
 		err = errorhandler.HandleApiError(err)
-		if err != config.EmptyError {
+		if !errors.Is(err, config.EmptyError) {
 			displayer.DisplayMessage(err.Error(), displayer.Error, false, os.Stderr)
 		}
-		os.Exit(1)
+		if ctx.Err() != nil {
+			os.Exit(interruptedExitCode)
+		}
+		os.Exit(exitCode)
 	}
 }
@@ -0,0 +1,81 @@
+/*
+This file is part of REANA.
+Copyright (C) 2022 CERN.
+
+REANA is free software; you can redistribute it and/or modify it
+under the terms of the MIT License; see LICENSE file for more details.
+*/
+
+package compatibility
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// CheckInterval minimum interval between two requests of the version of the same server.
+const CheckInterval = 24 * time.Hour
+
+// FailedCheckInterval minimum interval between two requests of the version of the same server,
+// when the last one failed, e.g. because the server was not reachable.
+const FailedCheckInterval = time.Hour
+
+// CacheEntry version of a server and when it was requested.
+// Failed is set when the version could not be requested.
+type CacheEntry struct {
+	CheckedAt     time.Time `json:"checked_at"`
+	ServerVersion string    `json:"server_version,omitempty"`
+	Failed        bool      `json:"failed,omitempty"`
+}
+
+// Cache versions of the servers, indexed by server URL.
+type Cache map[string]CacheEntry
+
+// LoadCache reads the cache file in the given path.
+// Returns an empty cache if the file does not exist.
+func LoadCache(path string) (Cache, error) {
+	cache := Cache{}
+	content, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return cache, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("cannot read version cache '%s': %v", path, err)
+	}
+	if err := json.Unmarshal(content, &cache); err != nil {
+		return nil, fmt.Errorf("cannot parse version cache '%s': %v", path, err)
+	}
+	return cache, nil
+}
+
+// Save writes the cache to the file in the given path, creating its directory if needed.
+func (c Cache) Save(path string) error {
+	content, err := json.Marshal(c)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return err
+	}
+	return os.WriteFile(path, content, 0600)
+}
+
+// Recent returns the cached version of the server if it was requested less than CheckInterval
+// before now, or less than FailedCheckInterval if the request failed, in which case the version
+// is empty.
+func (c Cache) Recent(serverURL string, now time.Time) (string, bool) {
+	entry, ok := c[serverURL]
+	interval := CheckInterval
+	if entry.Failed {
+		interval = FailedCheckInterval
+	}
+	if !ok || now.Sub(entry.CheckedAt) >= interval || now.Before(entry.CheckedAt) {
+		return "", false
+	}
+	return entry.ServerVersion, true
+}
@@ -0,0 +1,125 @@
+/*
+This file is part of REANA.
+Copyright (C) 2022 CERN.
+
+REANA is free software; you can redistribute it and/or modify it
+under the terms of the MIT License; see LICENSE file for more details.
+*/
+
+// Package compatibility checks whether the versions of the client and of the REANA server are
+// compatible, caching the version of the servers so that they are checked at most once a day.
+package compatibility
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Status result of the comparison of the server version with the supported range.
+type Status int
+
+const (
+	// Compatible the server version is in the supported range.
+	Compatible Status = iota
+	// ServerTooOld the server version is older than the supported range.
+	ServerTooOld
+	// ServerTooNew the server version is newer than the supported range.
+	ServerTooNew
+	// Unknown no supported range is defined for the client version.
+	Unknown
+)
+
+// Range range of REANA server versions supported by a series of client versions, from Min
+// included to Max excluded.
+type Range struct {
+	Client string // major.minor version of the client
+	Min    string
+	Max    string
+}
+
+// SupportedRanges ranges of server versions supported by each series of client versions.
+var SupportedRanges = []Range{
+	{Client: "0.0", Min: "0.9.0", Max: "0.10.0"},
+}
+
+// Version major, minor and patch numbers of a version.
+type Version [3]int
+
+// ParseVersion parses versions such as v0.9.1, 0.9.1-alpha.1 or 0.9.0a5, ignoring the pre-release
+// suffix. Missing minor and patch numbers are considered zero.
+func ParseVersion(version string) (Version, error) {
+	var v Version
+	parts := strings.SplitN(strings.TrimPrefix(strings.TrimSpace(version), "v"), ".", 3)
+	for i, part := range parts {
+		digits := part
+		if end := strings.IndexFunc(part, isNotDigit); end >= 0 {
+			digits = part[:end]
+		}
+		number, err := strconv.Atoi(digits)
+		if err != nil {
+			if i == 0 {
+				return v, fmt.Errorf("invalid version '%s'", version)
+			}
+			break
+		}
+		v[i] = number
+		if digits != part {
+			break
+		}
+	}
+	return v, nil
+}
+
+func isNotDigit(r rune) bool {
+	return r < '0' || r > '9'
+}
+
+// Compare returns -1, 0 or 1 when v is older than, equal to or newer than other.
+func (v Version) Compare(other Version) int {
+	for i := range v {
+		if v[i] < other[i] {
+			return -1
+		}
+		if v[i] > other[i] {
+			return 1
+		}
+	}
+	return 0
+}
+
+// Check compares the server version with the range supported by the client version, which is
+// also returned. Returns Unknown when no range is defined for the client version.
+func Check(client, server string) (Status, Range, error) {
+	clientVersion, err := ParseVersion(client)
+	if err != nil {
+		return Unknown, Range{}, err
+	}
+	serverVersion, err := ParseVersion(server)
+	if err != nil {
+		return Unknown, Range{}, err
+	}
+
+	series := fmt.Sprintf("%d.%d", clientVersion[0], clientVersion[1])
+	for _, r := range SupportedRanges {
+		if r.Client != series {
+			continue
+		}
+		min, err := ParseVersion(r.Min)
+		if err != nil {
+			return Unknown, r, err
+		}
+		max, err := ParseVersion(r.Max)
+		if err != nil {
+			return Unknown, r, err
+		}
+		if serverVersion.Compare(min) < 0 {
+			return ServerTooOld, r, nil
+		}
+		if serverVersion.Compare(max) >= 0 {
+			return ServerTooNew, r, nil
+		}
+		return Compatible, r, nil
+	}
+	return Unknown, Range{}, nil
+}
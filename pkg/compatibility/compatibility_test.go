@@ -0,0 +1,123 @@
+/*
+This file is part of REANA.
+Copyright (C) 2022 CERN.
+
+REANA is free software; you can redistribute it and/or modify it
+under the terms of the MIT License; see LICENSE file for more details.
+*/
+
+package compatibility
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestParseVersion(t *testing.T) {
+	tests := map[string]struct {
+		version   string
+		expected  Version
+		wantError bool
+	}{
+		"release":            {version: "0.9.1", expected: Version{0, 9, 1}},
+		"v prefix":           {version: "v1.2.3", expected: Version{1, 2, 3}},
+		"pre-release":        {version: "v0.0.0-alpha.1", expected: Version{0, 0, 0}},
+		"python pre-release": {version: "0.9.0a5", expected: Version{0, 9, 0}},
+		"major and minor":    {version: "0.10", expected: Version{0, 10, 0}},
+		"invalid":            {version: "latest", wantError: true},
+		"empty":              {version: "", wantError: true},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := ParseVersion(test.version)
+			if test.wantError {
+				if err == nil {
+					t.Errorf("Expected error, instead got %v", got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Got unexpected error '%s'", err.Error())
+			}
+			if got != test.expected {
+				t.Errorf("Expected %v, instead got %v", test.expected, got)
+			}
+		})
+	}
+}
+
+func TestCheck(t *testing.T) {
+	tests := map[string]struct {
+		client    string
+		server    string
+		expected  Status
+		wantError bool
+	}{
+		"compatible":       {client: "v0.0.0-alpha.1", server: "0.9.0", expected: Compatible},
+		"compatible patch": {client: "v0.0.1", server: "0.9.3", expected: Compatible},
+		"server too old":   {client: "v0.0.0", server: "0.8.1", expected: ServerTooOld},
+		"server too new":   {client: "v0.0.0", server: "0.10.0", expected: ServerTooNew},
+		"unknown client":   {client: "v9.9.0", server: "0.9.0", expected: Unknown},
+		"invalid server":   {client: "v0.0.0", server: "unknown", wantError: true},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, _, err := Check(test.client, test.server)
+			if test.wantError {
+				if err == nil {
+					t.Errorf("Expected error, instead got %v", got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Got unexpected error '%s'", err.Error())
+			}
+			if got != test.expected {
+				t.Errorf("Expected status %v, instead got %v", test.expected, got)
+			}
+		})
+	}
+}
+
+func TestCache(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "reana", "versions.json")
+	cache, err := LoadCache(path)
+	if err != nil {
+		t.Fatalf("Got unexpected error '%s'", err.Error())
+	}
+	if len(cache) != 0 {
+		t.Errorf("Expected empty cache, instead got %v", cache)
+	}
+
+	now := time.Now()
+	cache["https://recent"] = CacheEntry{CheckedAt: now.Add(-time.Hour), ServerVersion: "0.9.0"}
+	cache["https://old"] = CacheEntry{CheckedAt: now.Add(-25 * time.Hour), ServerVersion: "0.9.0"}
+	cache["https://down"] = CacheEntry{CheckedAt: now.Add(-time.Minute), Failed: true}
+	cache["https://was-down"] = CacheEntry{CheckedAt: now.Add(-2 * time.Hour), Failed: true}
+	if err := cache.Save(path); err != nil {
+		t.Fatalf("Got unexpected error '%s'", err.Error())
+	}
+	cache, err = LoadCache(path)
+	if err != nil {
+		t.Fatalf("Got unexpected error '%s'", err.Error())
+	}
+
+	if version, ok := cache.Recent("https://recent", now); !ok || version != "0.9.0" {
+		t.Errorf("Expected recent version 0.9.0, instead got '%s', %t", version, ok)
+	}
+	if _, ok := cache.Recent("https://old", now); ok {
+		t.Errorf("Expected version checked more than a day ago not to be recent")
+	}
+	if version, ok := cache.Recent("https://down", now); !ok || version != "" {
+		t.Errorf("Expected recent failure, instead got '%s', %t", version, ok)
+	}
+	if _, ok := cache.Recent("https://was-down", now); ok {
+		t.Errorf("Expected failure more than an hour ago not to be recent")
+	}
+	if _, ok := cache.Recent("https://unknown", now); ok {
+		t.Errorf("Expected unknown server not to be recent")
+	}
+}
@@ -28,6 +28,9 @@ var ReanaComputeBackends = map[string]string{
 // These keys are the same used in ReanaComputeBackends.
 var ReanaComputeBackendKeys = []string{"kubernetes", "htcondor", "slurm"}
 
+// WorkflowTypes list of supported workflow engines.
+var WorkflowTypes = []string{"serial", "cwl", "yadage", "snakemake"}
+
 // LeadingMark prefix used when displaying headers or important messages.
 var LeadingMark = "==>"
 
@@ -49,12 +52,21 @@ func GetRunStatuses(includeDeleted bool) []string {
 	return runStatuses
 }
 
+// EndedRunStatuses statuses of the workflows that will not change anymore, unless restarted.
+var EndedRunStatuses = []string{"finished", "failed", "stopped", "deleted"}
+
 // DuMultiFilters available filters with multiple values in du command.
 var DuMultiFilters = []string{"size", "name"}
 
 // ListMultiFilters available filters with multiple values in list command.
 var ListMultiFilters = []string{"name", "status"}
 
+// ListClientFilters available filters applied client-side in list command, on the columns.
+var ListClientFilters = []string{
+	"name", "run_number", "created", "started", "ended", "status", "id", "user", "size",
+	"progress", "duration", "session_type", "session_uri", "session_status",
+}
+
 // LogsSingleFilters available filters with a single value in logs command.
 var LogsSingleFilters = []string{"compute_backend", "docker_img", "status"}
 
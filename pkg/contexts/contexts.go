@@ -0,0 +1,183 @@
+/*
+This file is part of REANA.
+Copyright (C) 2022 CERN.
+
+REANA is free software; you can redistribute it and/or modify it
+under the terms of the MIT License; see LICENSE file for more details.
+*/
+
+/*
+Package contexts manages the client configuration file, which stores named contexts.
+
+Each context holds the server URL, access token and workflow to use when working against a REANA
+instance, so that users can switch between instances (e.g. dev, staging and prod) without changing
+environment variables.
+*/
+package contexts
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"reanahub/reana-client-go/pkg/validator"
+
+	"gopkg.in/yaml.v3"
+)
+
+// ConfigPathEnv environment variable that overrides the location of the configuration file.
+const ConfigPathEnv = "REANA_CONFIG"
+
+// Keys the values that can be stored in a context, named as the respective command line flags.
+var Keys = []string{"server-url", "access-token", "workflow", "credential-store"}
+
+// Context represents the values used to work against a REANA instance.
+type Context struct {
+	ServerURL       string `yaml:"server-url,omitempty"`
+	AccessToken     string `yaml:"access-token,omitempty"`
+	Workflow        string `yaml:"workflow,omitempty"`
+	CredentialStore string `yaml:"credential-store,omitempty"`
+}
+
+// Values returns the values set in the context, indexed by their key.
+func (c Context) Values() map[string]any {
+	values := map[string]any{}
+	for key, value := range map[string]string{
+		"server-url":       c.ServerURL,
+		"access-token":     c.AccessToken,
+		"workflow":         c.Workflow,
+		"credential-store": c.CredentialStore,
+	} {
+		if value != "" {
+			values[key] = value
+		}
+	}
+	return values
+}
+
+// Config represents the content of the configuration file.
+type Config struct {
+	CurrentContext string             `yaml:"current-context,omitempty"`
+	Contexts       map[string]Context `yaml:"contexts,omitempty"`
+}
+
+// DefaultPath returns the path of the configuration file, which is reana/config.yaml in the user
+// configuration directory (e.g. $XDG_CONFIG_HOME), unless overridden by ConfigPathEnv.
+func DefaultPath() (string, error) {
+	if path := os.Getenv(ConfigPathEnv); path != "" {
+		return path, nil
+	}
+	configDir, err := os.UserConfigDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(configDir, "reana", "config.yaml"), nil
+}
+
+// Load reads the configuration file in the given path.
+// Returns an empty configuration if the file does not exist.
+func Load(path string) (*Config, error) {
+	config := &Config{}
+	content, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return config, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("cannot read configuration file '%s': %v", path, err)
+	}
+	if err := yaml.Unmarshal(content, config); err != nil {
+		return nil, fmt.Errorf("cannot parse configuration file '%s': %v", path, err)
+	}
+	return config, nil
+}
+
+// Save writes the configuration to the file in the given path, creating its directory if needed.
+// The file is only readable by the user, since it may contain access tokens.
+func (c *Config) Save(path string) error {
+	content, err := yaml.Marshal(c)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return err
+	}
+	return os.WriteFile(path, content, 0600)
+}
+
+// Get returns the context with the given name, or the current context if name is empty.
+// Returns false if no context is selected.
+func (c *Config) Get(name string) (Context, bool, error) {
+	if name == "" {
+		name = c.CurrentContext
+	}
+	if name == "" {
+		return Context{}, false, nil
+	}
+	context, ok := c.Contexts[name]
+	if !ok {
+		return Context{}, false, fmt.Errorf("context '%s' does not exist", name)
+	}
+	return context, true, nil
+}
+
+// Use sets the context with the given name as the current context.
+func (c *Config) Use(name string) error {
+	if _, ok := c.Contexts[name]; !ok {
+		return fmt.Errorf("context '%s' does not exist", name)
+	}
+	c.CurrentContext = name
+	return nil
+}
+
+// Set sets the value of the given key in the context with the given name, creating the context if needed.
+// The created context becomes the current one if there is none.
+func (c *Config) Set(name, key, value string) error {
+	if err := validator.ValidateChoice(key, Keys, "key"); err != nil {
+		return err
+	}
+
+	if c.Contexts == nil {
+		c.Contexts = map[string]Context{}
+	}
+	context := c.Contexts[name]
+	switch key {
+	case "server-url":
+		context.ServerURL = value
+	case "access-token":
+		context.AccessToken = value
+	case "workflow":
+		context.Workflow = value
+	case "credential-store":
+		context.CredentialStore = value
+	}
+	c.Contexts[name] = context
+
+	if c.CurrentContext == "" {
+		c.CurrentContext = name
+	}
+	return nil
+}
+
+// Unset removes the value of the given key from the context with the given name.
+func (c *Config) Unset(name, key string) error {
+	if err := validator.ValidateChoice(key, Keys, "key"); err != nil {
+		return err
+	}
+	context, ok := c.Contexts[name]
+	if !ok {
+		return fmt.Errorf("context '%s' does not exist", name)
+	}
+	switch key {
+	case "server-url":
+		context.ServerURL = ""
+	case "access-token":
+		context.AccessToken = ""
+	case "workflow":
+		context.Workflow = ""
+	case "credential-store":
+		context.CredentialStore = ""
+	}
+	c.Contexts[name] = context
+	return nil
+}
@@ -0,0 +1,177 @@
+/*
+This file is part of REANA.
+Copyright (C) 2022 CERN.
+
+REANA is free software; you can redistribute it and/or modify it
+under the terms of the MIT License; see LICENSE file for more details.
+*/
+
+package contexts
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestDefaultPath(t *testing.T) {
+	t.Run("xdg config home", func(t *testing.T) {
+		t.Setenv(ConfigPathEnv, "")
+		t.Setenv("XDG_CONFIG_HOME", "/tmp/config")
+		path, err := DefaultPath()
+		if err != nil {
+			t.Fatalf("Got unexpected error '%s'", err.Error())
+		}
+		// The user configuration directory only honours XDG_CONFIG_HOME on Unix systems
+		if filepath.Base(path) != "config.yaml" || filepath.Base(filepath.Dir(path)) != "reana" {
+			t.Errorf("Expected path ending with reana/config.yaml, instead got '%s'", path)
+		}
+	})
+
+	t.Run("environment override", func(t *testing.T) {
+		t.Setenv(ConfigPathEnv, "/tmp/reana.yaml")
+		path, err := DefaultPath()
+		if err != nil {
+			t.Fatalf("Got unexpected error '%s'", err.Error())
+		}
+		if path != "/tmp/reana.yaml" {
+			t.Errorf("Expected path '/tmp/reana.yaml', instead got '%s'", path)
+		}
+	})
+}
+
+func TestLoadAndSave(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "reana", "config.yaml")
+
+	config, err := Load(path)
+	if err != nil {
+		t.Fatalf("Got unexpected error '%s'", err.Error())
+	}
+	if !reflect.DeepEqual(config, &Config{}) {
+		t.Fatalf("Expected empty config for missing file, instead got %v", config)
+	}
+
+	if err := config.Set("dev", "server-url", "https://reana-dev.cern.ch"); err != nil {
+		t.Fatalf("Got unexpected error '%s'", err.Error())
+	}
+	if err := config.Set("prod", "access-token", "secret"); err != nil {
+		t.Fatalf("Got unexpected error '%s'", err.Error())
+	}
+	if err := config.Save(path); err != nil {
+		t.Fatalf("Got unexpected error '%s'", err.Error())
+	}
+
+	info, err := os.Stat(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if info.Mode().Perm() != 0600 {
+		t.Errorf("Expected file permissions 0600, instead got %v", info.Mode().Perm())
+	}
+
+	loaded, err := Load(path)
+	if err != nil {
+		t.Fatalf("Got unexpected error '%s'", err.Error())
+	}
+	expected := &Config{
+		CurrentContext: "dev",
+		Contexts: map[string]Context{
+			"dev":  {ServerURL: "https://reana-dev.cern.ch"},
+			"prod": {AccessToken: "secret"},
+		},
+	}
+	if !reflect.DeepEqual(loaded, expected) {
+		t.Errorf("Expected config %v, instead got %v", expected, loaded)
+	}
+}
+
+func TestLoadInvalid(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	if err := os.WriteFile(path, []byte("contexts: [dev"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := Load(path); err == nil {
+		t.Errorf("Expected error for invalid configuration file")
+	}
+}
+
+func TestGetAndUse(t *testing.T) {
+	config := &Config{
+		CurrentContext: "dev",
+		Contexts: map[string]Context{
+			"dev":  {ServerURL: "https://reana-dev.cern.ch"},
+			"prod": {ServerURL: "https://reana.cern.ch", Workflow: "analysis"},
+		},
+	}
+
+	context, found, err := config.Get("")
+	if err != nil || !found || context.ServerURL != "https://reana-dev.cern.ch" {
+		t.Errorf("Expected current context dev, instead got %v, %t, %v", context, found, err)
+	}
+	context, found, err = config.Get("prod")
+	if err != nil || !found || context.Workflow != "analysis" {
+		t.Errorf("Expected context prod, instead got %v, %t, %v", context, found, err)
+	}
+	if _, _, err = config.Get("staging"); err == nil ||
+		err.Error() != "context 'staging' does not exist" {
+		t.Errorf("Expected error for missing context, instead got %v", err)
+	}
+
+	if err := config.Use("prod"); err != nil {
+		t.Fatalf("Got unexpected error '%s'", err.Error())
+	}
+	if config.CurrentContext != "prod" {
+		t.Errorf("Expected current context prod, instead got %s", config.CurrentContext)
+	}
+	if err := config.Use("staging"); err == nil {
+		t.Errorf("Expected error when using a missing context")
+	}
+
+	empty := &Config{}
+	if _, found, err := empty.Get(""); found || err != nil {
+		t.Errorf("Expected no context selected, instead got %t, %v", found, err)
+	}
+}
+
+func TestSetInvalidKey(t *testing.T) {
+	config := &Config{}
+	err := config.Set("dev", "token", "secret")
+	expected := "invalid value for 'key': 'token' is not part of " +
+		"'server-url', 'access-token', 'workflow', 'credential-store'"
+	if err == nil || err.Error() != expected {
+		t.Errorf("Expected error '%s', instead got %v", expected, err)
+	}
+}
+
+func TestUnset(t *testing.T) {
+	config := &Config{
+		Contexts: map[string]Context{
+			"dev": {ServerURL: "https://reana-dev.cern.ch", AccessToken: "secret"},
+		},
+	}
+	if err := config.Unset("dev", "access-token"); err != nil {
+		t.Fatalf("Got unexpected error '%s'", err.Error())
+	}
+	expected := Context{ServerURL: "https://reana-dev.cern.ch"}
+	if config.Contexts["dev"] != expected {
+		t.Errorf("Expected context %v, instead got %v", expected, config.Contexts["dev"])
+	}
+	if err := config.Unset("prod", "access-token"); err == nil {
+		t.Errorf("Expected error when unsetting a value of a missing context")
+	}
+	if err := config.Unset("dev", "token"); err == nil {
+		t.Errorf("Expected error when unsetting an invalid key")
+	}
+}
+
+func TestContextValues(t *testing.T) {
+	context := Context{ServerURL: "https://reana.cern.ch", Workflow: "analysis"}
+	expected := map[string]any{
+		"server-url": "https://reana.cern.ch",
+		"workflow":   "analysis",
+	}
+	if values := context.Values(); !reflect.DeepEqual(values, expected) {
+		t.Errorf("Expected values %v, instead got %v", expected, values)
+	}
+}
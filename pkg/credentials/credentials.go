@@ -0,0 +1,48 @@
+/*
+This file is part of REANA.
+Copyright (C) 2022 CERN.
+
+REANA is free software; you can redistribute it and/or modify it
+under the terms of the MIT License; see LICENSE file for more details.
+*/
+
+/*
+Package credentials stores the access tokens of the configuration contexts outside of the
+configuration file, either in the keyring of the operating system or in a file encrypted with a
+passphrase.
+*/
+package credentials
+
+import (
+	"errors"
+	"reanahub/reana-client-go/pkg/validator"
+)
+
+// Backends the supported credential stores.
+var Backends = []string{"keyring", "file"}
+
+// ErrNotFound is returned when no access token is stored for a context.
+var ErrNotFound = errors.New("credentials not found")
+
+// Store stores the access tokens, indexed by the name of their context.
+type Store interface {
+	// Get returns the access token of the context, or ErrNotFound.
+	Get(context string) (string, error)
+	// Set stores the access token of the context, replacing the existing one.
+	Set(context, token string) error
+	// Delete removes the access token of the context, if any.
+	Delete(context string) error
+}
+
+// New creates the credential store of the given backend.
+// The file backend stores the tokens in the file in path, encrypted with the passphrase returned by
+// passphrase, which is only called when the file is accessed.
+func New(backend, path string, passphrase func() (string, error)) (Store, error) {
+	if err := validator.ValidateChoice(backend, Backends, "credential-store"); err != nil {
+		return nil, err
+	}
+	if backend == "keyring" {
+		return newKeyringStore()
+	}
+	return &fileStore{path: path, passphrase: passphrase, iterations: kdfIterations}, nil
+}
@@ -0,0 +1,171 @@
+/*
+This file is part of REANA.
+Copyright (C) 2022 CERN.
+
+REANA is free software; you can redistribute it and/or modify it
+under the terms of the MIT License; see LICENSE file for more details.
+*/
+
+package credentials
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+
+	"golang.org/x/crypto/pbkdf2"
+)
+
+const (
+	// kdfIterations number of PBKDF2 iterations deriving the encryption key from the passphrase.
+	kdfIterations = 210000
+	// keyLength length of the AES-256 key.
+	keyLength = 32
+	// saltLength length of the random salt of the key derivation.
+	saltLength = 16
+)
+
+// encryptedFile represents the content of the credentials file.
+// The access tokens are encrypted with AES-GCM, with a key derived from the passphrase with PBKDF2.
+type encryptedFile struct {
+	Salt       []byte `json:"salt"`
+	Nonce      []byte `json:"nonce"`
+	Ciphertext []byte `json:"ciphertext"`
+}
+
+// fileStore stores the access tokens in a file encrypted with a passphrase.
+type fileStore struct {
+	path       string
+	passphrase func() (string, error)
+	iterations int
+	secret     string // passphrase, once asked
+}
+
+// Get returns the access token of the context from the file.
+func (s *fileStore) Get(context string) (string, error) {
+	tokens, err := s.load()
+	if err != nil {
+		return "", err
+	}
+	token, ok := tokens[context]
+	if !ok {
+		return "", ErrNotFound
+	}
+	return token, nil
+}
+
+// Set stores the access token of the context in the file.
+func (s *fileStore) Set(context, token string) error {
+	tokens, err := s.load()
+	if err != nil {
+		return err
+	}
+	tokens[context] = token
+	return s.save(tokens)
+}
+
+// Delete removes the access token of the context from the file.
+func (s *fileStore) Delete(context string) error {
+	tokens, err := s.load()
+	if err != nil {
+		return err
+	}
+	if _, ok := tokens[context]; !ok {
+		return nil
+	}
+	delete(tokens, context)
+	return s.save(tokens)
+}
+
+// load decrypts the access tokens of the file, indexed by context.
+// Returns no tokens if the file does not exist.
+func (s *fileStore) load() (map[string]string, error) {
+	tokens := map[string]string{}
+	content, err := os.ReadFile(s.path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return tokens, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("cannot read credentials file '%s': %v", s.path, err)
+	}
+
+	var file encryptedFile
+	if err := json.Unmarshal(content, &file); err != nil {
+		return nil, fmt.Errorf("cannot parse credentials file '%s': %v", s.path, err)
+	}
+	gcm, err := s.cipher(file.Salt)
+	if err != nil {
+		return nil, err
+	}
+	plaintext, err := gcm.Open(nil, file.Nonce, file.Ciphertext, nil)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"cannot decrypt credentials file '%s': wrong passphrase or corrupted file",
+			s.path,
+		)
+	}
+	if err := json.Unmarshal(plaintext, &tokens); err != nil {
+		return nil, fmt.Errorf("cannot parse credentials file '%s': %v", s.path, err)
+	}
+	return tokens, nil
+}
+
+// save encrypts the access tokens in the file, with a new salt and nonce.
+// The file is only readable by the user.
+func (s *fileStore) save(tokens map[string]string) error {
+	plaintext, err := json.Marshal(tokens)
+	if err != nil {
+		return err
+	}
+
+	file := encryptedFile{Salt: make([]byte, saltLength)}
+	if _, err := rand.Read(file.Salt); err != nil {
+		return err
+	}
+	gcm, err := s.cipher(file.Salt)
+	if err != nil {
+		return err
+	}
+	file.Nonce = make([]byte, gcm.NonceSize())
+	if _, err := rand.Read(file.Nonce); err != nil {
+		return err
+	}
+	file.Ciphertext = gcm.Seal(nil, file.Nonce, plaintext, nil)
+
+	content, err := json.Marshal(file)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(s.path), 0700); err != nil {
+		return err
+	}
+	return os.WriteFile(s.path, content, 0600)
+}
+
+// cipher creates the AES-GCM cipher with the key derived from the passphrase and the given salt.
+func (s *fileStore) cipher(salt []byte) (cipher.AEAD, error) {
+	if s.secret == "" {
+		passphrase, err := s.passphrase()
+		if err != nil {
+			return nil, err
+		}
+		if passphrase == "" {
+			return nil, errors.New("the passphrase of the credentials file cannot be empty")
+		}
+		s.secret = passphrase
+	}
+
+	key := pbkdf2.Key([]byte(s.secret), salt, s.iterations, keyLength, sha256.New)
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
@@ -0,0 +1,95 @@
+/*
+This file is part of REANA.
+Copyright (C) 2022 CERN.
+
+REANA is free software; you can redistribute it and/or modify it
+under the terms of the MIT License; see LICENSE file for more details.
+*/
+
+package credentials
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func newTestFileStore(path, passphrase string) *fileStore {
+	return &fileStore{
+		path:       path,
+		passphrase: func() (string, error) { return passphrase, nil },
+		iterations: 1000,
+	}
+}
+
+func TestFileStore(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "reana", "credentials.enc")
+	store := newTestFileStore(path, "secret passphrase")
+
+	if _, err := store.Get("dev"); !errors.Is(err, ErrNotFound) {
+		t.Fatalf("Expected not found error for missing file, instead got %v", err)
+	}
+	if err := store.Set("dev", "dev-token"); err != nil {
+		t.Fatalf("Got unexpected error '%s'", err.Error())
+	}
+	if err := store.Set("prod", "prod-token"); err != nil {
+		t.Fatalf("Got unexpected error '%s'", err.Error())
+	}
+
+	content, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(string(content), "dev-token") {
+		t.Errorf("Expected encrypted tokens, instead got '%s'", content)
+	}
+	info, err := os.Stat(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if info.Mode().Perm() != 0600 {
+		t.Errorf("Expected file permissions 0600, instead got %v", info.Mode().Perm())
+	}
+
+	// a new store reads the tokens with the same passphrase
+	store = newTestFileStore(path, "secret passphrase")
+	if token, err := store.Get("prod"); err != nil || token != "prod-token" {
+		t.Errorf("Expected token prod-token, instead got '%s', %v", token, err)
+	}
+	if err := store.Delete("prod"); err != nil {
+		t.Fatalf("Got unexpected error '%s'", err.Error())
+	}
+	if _, err := store.Get("prod"); !errors.Is(err, ErrNotFound) {
+		t.Errorf("Expected not found error for deleted token, instead got %v", err)
+	}
+	if token, err := store.Get("dev"); err != nil || token != "dev-token" {
+		t.Errorf("Expected token dev-token, instead got '%s', %v", token, err)
+	}
+}
+
+func TestFileStoreErrors(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "credentials.enc")
+	if err := newTestFileStore(path, "secret").Set("dev", "dev-token"); err != nil {
+		t.Fatalf("Got unexpected error '%s'", err.Error())
+	}
+
+	_, err := newTestFileStore(path, "wrong").Get("dev")
+	if err == nil || !strings.Contains(err.Error(), "wrong passphrase or corrupted file") {
+		t.Errorf("Expected decryption error, instead got %v", err)
+	}
+
+	err = newTestFileStore(filepath.Join(t.TempDir(), "new.enc"), "").Set("dev", "dev-token")
+	if err == nil || err.Error() != "the passphrase of the credentials file cannot be empty" {
+		t.Errorf("Expected empty passphrase error, instead got %v", err)
+	}
+
+	if err := os.WriteFile(path, []byte("not json"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	_, err = newTestFileStore(path, "secret").Get("dev")
+	if err == nil || !strings.Contains(err.Error(), "cannot parse credentials file") {
+		t.Errorf("Expected parse error, instead got %v", err)
+	}
+}
@@ -0,0 +1,90 @@
+/*
+This file is part of REANA.
+Copyright (C) 2022 CERN.
+
+REANA is free software; you can redistribute it and/or modify it
+under the terms of the MIT License; see LICENSE file for more details.
+*/
+
+package credentials
+
+import (
+	"errors"
+	"fmt"
+	"os/exec"
+	"strings"
+)
+
+// keyringService attribute identifying the secrets of the client in the keyring.
+const keyringService = "reana-client"
+
+// keyringStore stores the access tokens in the keyring of the user, through the Secret Service API.
+// The secrets are managed with the secret-tool command of libsecret.
+type keyringStore struct {
+	command string
+}
+
+// newKeyringStore creates a keyringStore, checking that secret-tool is available.
+func newKeyringStore() (*keyringStore, error) {
+	command, err := exec.LookPath("secret-tool")
+	if err != nil {
+		return nil, fmt.Errorf(
+			"the keyring credential store requires the secret-tool command of libsecret: %v",
+			err,
+		)
+	}
+	return &keyringStore{command: command}, nil
+}
+
+// Get returns the access token of the context from the keyring.
+func (s *keyringStore) Get(context string) (string, error) {
+	out, err := exec.Command(
+		s.command, "lookup", "service", keyringService, "context", context,
+	).Output()
+	var exitErr *exec.ExitError
+	// secret-tool exits with an error and no output when the secret does not exist
+	if errors.As(err, &exitErr) && len(out) == 0 && len(exitErr.Stderr) == 0 {
+		return "", ErrNotFound
+	}
+	if err != nil {
+		return "", keyringError(err)
+	}
+	return strings.TrimSpace(string(out)), nil
+}
+
+// Set stores the access token of the context in the keyring.
+func (s *keyringStore) Set(context, token string) error {
+	cmd := exec.Command(
+		s.command,
+		"store",
+		"--label", fmt.Sprintf("REANA access token (%s)", context),
+		"service", keyringService,
+		"context", context,
+	)
+	cmd.Stdin = strings.NewReader(token)
+	if _, err := cmd.Output(); err != nil {
+		return keyringError(err)
+	}
+	return nil
+}
+
+// Delete removes the access token of the context from the keyring.
+func (s *keyringStore) Delete(context string) error {
+	_, err := exec.Command(
+		s.command, "clear", "service", keyringService, "context", context,
+	).Output()
+	if err != nil {
+		return keyringError(err)
+	}
+	return nil
+}
+
+// keyringError describes the error of a secret-tool command, including its error output.
+func keyringError(err error) error {
+	var exitErr *exec.ExitError
+	if errors.As(err, &exitErr) && len(exitErr.Stderr) > 0 {
+		stderr := strings.TrimSpace(string(exitErr.Stderr))
+		return fmt.Errorf("cannot access the keyring: %s", stderr)
+	}
+	return fmt.Errorf("cannot access the keyring: %v", err)
+}
@@ -0,0 +1,88 @@
+/*
+This file is part of REANA.
+Copyright (C) 2022 CERN.
+
+REANA is free software; you can redistribute it and/or modify it
+under the terms of the MIT License; see LICENSE file for more details.
+*/
+
+package credentials
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// fakeSecretTool emulates secret-tool, storing each secret in a file named after its context.
+const fakeSecretTool = `#!/bin/sh
+case "$1" in
+store) cat > "$KEYRING_DIR/$7" ;;
+lookup) [ -f "$KEYRING_DIR/$5" ] && cat "$KEYRING_DIR/$5" || exit 1 ;;
+clear) rm -f "$KEYRING_DIR/$5" ;;
+*) echo "unknown command" >&2; exit 2 ;;
+esac
+`
+
+func TestKeyringStore(t *testing.T) {
+	dir := t.TempDir()
+	command := filepath.Join(dir, "secret-tool")
+	if err := os.WriteFile(command, []byte(fakeSecretTool), 0700); err != nil {
+		t.Fatal(err)
+	}
+	keyringDir := filepath.Join(dir, "keyring")
+	if err := os.Mkdir(keyringDir, 0700); err != nil {
+		t.Fatal(err)
+	}
+	t.Setenv("KEYRING_DIR", keyringDir)
+	t.Setenv("PATH", dir+string(os.PathListSeparator)+os.Getenv("PATH"))
+
+	store, err := New("keyring", "", nil)
+	if err != nil {
+		t.Fatalf("Got unexpected error '%s'", err.Error())
+	}
+	if _, err := store.Get("dev"); !errors.Is(err, ErrNotFound) {
+		t.Fatalf("Expected not found error, instead got %v", err)
+	}
+	if err := store.Set("dev", "dev-token"); err != nil {
+		t.Fatalf("Got unexpected error '%s'", err.Error())
+	}
+	if token, err := store.Get("dev"); err != nil || token != "dev-token" {
+		t.Errorf("Expected token dev-token, instead got '%s', %v", token, err)
+	}
+	if err := store.Delete("dev"); err != nil {
+		t.Fatalf("Got unexpected error '%s'", err.Error())
+	}
+	if _, err := store.Get("dev"); !errors.Is(err, ErrNotFound) {
+		t.Errorf("Expected not found error for deleted token, instead got %v", err)
+	}
+
+	failing := &keyringStore{command: filepath.Join(dir, "missing")}
+	if _, err := failing.Get("dev"); err == nil ||
+		!strings.HasPrefix(err.Error(), "cannot access the keyring") {
+		t.Errorf("Expected keyring error, instead got %v", err)
+	}
+}
+
+func TestNew(t *testing.T) {
+	t.Setenv("PATH", t.TempDir())
+	if _, err := New("keyring", "", nil); err == nil ||
+		!strings.Contains(err.Error(), "requires the secret-tool command") {
+		t.Errorf("Expected missing secret-tool error, instead got %v", err)
+	}
+
+	if _, err := New("vault", "", nil); err == nil ||
+		!strings.Contains(err.Error(), "invalid value for 'credential-store'") {
+		t.Errorf("Expected invalid backend error, instead got %v", err)
+	}
+
+	store, err := New("file", "credentials.enc", nil)
+	if err != nil {
+		t.Fatalf("Got unexpected error '%s'", err.Error())
+	}
+	if _, ok := store.(*fileStore); !ok {
+		t.Errorf("Expected file store, instead got %T", store)
+	}
+}
@@ -25,6 +25,17 @@ func HasAnyPrefix(s string, prefixes []string) bool {
 	return false
 }
 
+// ContainsAny checks if the string s contains any of the substrings, by running strings.Contains
+// for each one.
+func ContainsAny(s string, substrings []string) bool {
+	for _, substring := range substrings {
+		if strings.Contains(s, substring) {
+			return true
+		}
+	}
+	return false
+}
+
 // FromIsoToTimestamp converts a string date in the ISO format to a timestamp.
 func FromIsoToTimestamp(date string) (time.Time, error) {
 	timestamp, err := time.Parse("2006-01-02T15:04:05", date)
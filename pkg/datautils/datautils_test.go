@@ -39,6 +39,29 @@ func TestHasAnyPrefix(t *testing.T) {
 	}
 }
 
+func TestContainsAny(t *testing.T) {
+	tests := map[string]struct {
+		substrings []string
+		str        string
+		want       bool
+	}{
+		"prefix":        {substrings: []string{"/.git/"}, str: "/.git/config", want: true},
+		"nested":        {substrings: []string{"/.git/"}, str: "/code/.git/config", want: true},
+		"not contained": {substrings: []string{"/.git/"}, str: "/code/.gitignore", want: false},
+		"two options":   {substrings: []string{"foo", "bar"}, str: "xbarx", want: true},
+		"no options":    {substrings: []string{}, str: "foobar", want: false},
+		"empty string":  {substrings: []string{"foo", "bar"}, str: "", want: false},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			got := ContainsAny(test.str, test.substrings)
+			if got != test.want {
+				t.Errorf("Expected %t, got %t", test.want, got)
+			}
+		})
+	}
+}
+
 func TestFromIsoToTimestamp(t *testing.T) {
 	now := time.Now().UTC()
 	tests := map[string]struct {
@@ -0,0 +1,109 @@
+/*
+This file is part of REANA.
+Copyright (C) 2022 CERN.
+
+REANA is free software; you can redistribute it and/or modify it
+under the terms of the MIT License; see LICENSE file for more details.
+*/
+
+package displayer
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/jedib0t/go-pretty/v6/text"
+	"golang.org/x/term"
+)
+
+// Dashboard displays information that is refreshed periodically, e.g. with the --watch flags.
+// On a terminal, the lines of the dashboard are redrawn in place. Otherwise, a summary line is
+// printed each time it changes, so that the output can be redirected to a file.
+type Dashboard struct {
+	out      io.Writer
+	terminal bool
+	// width returns the width of the terminal in columns, or 0 when it is unknown
+	width       func() int
+	drawnRows   int
+	lastSummary string
+}
+
+// NewDashboard returns a new Dashboard writing to out.
+func NewDashboard(out io.Writer) *Dashboard {
+	return &Dashboard{
+		out:      out,
+		terminal: IsTerminal(out),
+		width:    func() int { return terminalWidth(out) },
+	}
+}
+
+// IsTerminal checks if out is a terminal.
+func IsTerminal(out io.Writer) bool {
+	file, ok := out.(*os.File)
+	return ok && term.IsTerminal(int(file.Fd()))
+}
+
+// terminalWidth returns the width of the terminal out in columns, or 0 when it is unknown.
+func terminalWidth(out io.Writer) int {
+	file, ok := out.(*os.File)
+	if !ok {
+		return 0
+	}
+	width, _, err := term.GetSize(int(file.Fd()))
+	if err != nil {
+		return 0
+	}
+	return width
+}
+
+// physicalRows returns the number of rows taken by the lines on a terminal of the given width,
+// the lines wider than the terminal being wrapped on several rows, e.g. wide table rows.
+func physicalRows(lines []string, width int) int {
+	rows := 0
+	for _, line := range lines {
+		lineWidth := text.RuneWidthWithoutEscSequences(line)
+		if width <= 0 || lineWidth <= width {
+			rows++
+			continue
+		}
+		rows += (lineWidth + width - 1) / width
+	}
+	return rows
+}
+
+// Update displays the new state of the dashboard: the lines replace the previous ones on a
+// terminal, while the summary is printed otherwise, unless it is empty or did not change.
+func (d *Dashboard) Update(lines []string, summary string) {
+	if !d.terminal {
+		if summary != "" && summary != d.lastSummary {
+			fmt.Fprintln(d.out, summary)
+			d.lastSummary = summary
+		}
+		return
+	}
+
+	if d.drawnRows > 0 {
+		// move to the first row previously drawn and erase the rest of the screen
+		fmt.Fprintf(d.out, "\033[%dF\033[J", d.drawnRows)
+	}
+	fmt.Fprintln(d.out, strings.Join(lines, "\n"))
+	d.drawnRows = physicalRows(lines, d.width())
+}
+
+// ProgressBar returns a progress bar of the given width, e.g. [#####-----] 50%.
+func ProgressBar(done, total int64, width int) string {
+	ratio := 0.0
+	if total > 0 {
+		ratio = float64(done) / float64(total)
+	}
+	if ratio > 1 {
+		ratio = 1
+	}
+	filled := int(ratio * float64(width))
+	return fmt.Sprintf(
+		"[%s%s] %3.0f%%",
+		strings.Repeat("#", filled), strings.Repeat("-", width-filled), ratio*100,
+	)
+}
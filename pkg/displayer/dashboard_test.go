@@ -0,0 +1,96 @@
+/*
+This file is part of REANA.
+Copyright (C) 2022 CERN.
+
+REANA is free software; you can redistribute it and/or modify it
+under the terms of the MIT License; see LICENSE file for more details.
+*/
+
+package displayer
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestDashboardUpdate(t *testing.T) {
+	tests := map[string]struct {
+		terminal bool
+		expected string
+	}{
+		"terminal": {
+			terminal: true,
+			expected: "a\nb\n" + strings.Repeat("\033[2F\033[Jc\nd\n", 3),
+		},
+		"not a terminal": {
+			terminal: false,
+			expected: "a b\nc d\n",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			buf := new(bytes.Buffer)
+			dashboard := NewDashboard(buf)
+			dashboard.terminal = test.terminal
+
+			dashboard.Update([]string{"a", "b"}, "a b")
+			dashboard.Update([]string{"c", "d"}, "c d")
+			dashboard.Update([]string{"c", "d"}, "c d")
+			dashboard.Update([]string{"c", "d"}, "")
+			if buf.String() != test.expected {
+				t.Errorf("Expected: %q, got: %q", test.expected, buf.String())
+			}
+		})
+	}
+}
+
+func TestDashboardWrappedLines(t *testing.T) {
+	buf := new(bytes.Buffer)
+	dashboard := NewDashboard(buf)
+	dashboard.terminal = true
+	dashboard.width = func() int { return 4 }
+
+	// the first line is wrapped on 3 rows, the color codes not taking any column
+	first := []string{"abcdefghi", "\033[33mabcd\033[0m", ""}
+	dashboard.Update(first, "")
+	dashboard.Update([]string{"c"}, "")
+	expected := strings.Join(first, "\n") + "\n\033[5F\033[Jc\n"
+	if buf.String() != expected {
+		t.Errorf("Expected: %q, got: %q", expected, buf.String())
+	}
+}
+
+func TestPhysicalRows(t *testing.T) {
+	lines := []string{"abcdefgh", "abc", ""}
+	tests := map[int]int{0: 3, 3: 5, 4: 4, 8: 3, 80: 3}
+
+	for width, expected := range tests {
+		if rows := physicalRows(lines, width); rows != expected {
+			t.Errorf("Expected %d rows with width %d, got %d", expected, width, rows)
+		}
+	}
+}
+
+func TestProgressBar(t *testing.T) {
+	tests := map[string]struct {
+		done, total int64
+		expected    string
+	}{
+		"empty":    {done: 0, total: 4, expected: "[--------]   0%"},
+		"half":     {done: 2, total: 4, expected: "[####----]  50%"},
+		"complete": {done: 4, total: 4, expected: "[########] 100%"},
+		"no total": {done: 0, total: 0, expected: "[--------]   0%"},
+		"overflow": {done: 5, total: 4, expected: "[########] 100%"},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			result := ProgressBar(test.done, test.total, 8)
+			if result != test.expected {
+				t.Errorf("Expected: '%s', got: '%s'", test.expected, result)
+			}
+		})
+	}
+}
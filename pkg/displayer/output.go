@@ -0,0 +1,406 @@
+/*
+This file is part of REANA.
+Copyright (C) 2022 CERN.
+
+REANA is free software; you can redistribute it and/or modify it
+under the terms of the MIT License; see LICENSE file for more details.
+*/
+
+package displayer
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"reanahub/reana-client-go/pkg/formatter"
+	"reflect"
+	"strconv"
+	"strings"
+	"text/template"
+
+	"github.com/go-gota/gota/dataframe"
+	"github.com/iancoleman/orderedmap"
+	"golang.org/x/exp/slices"
+	"gopkg.in/yaml.v3"
+	"k8s.io/client-go/util/jsonpath"
+)
+
+// OutputFormat represents a format in which the commands display their results.
+type OutputFormat string
+
+const (
+	TableFormat    OutputFormat = "table"
+	WideFormat     OutputFormat = "wide"
+	JSONFormat     OutputFormat = "json"
+	YAMLFormat     OutputFormat = "yaml"
+	CSVFormat      OutputFormat = "csv"
+	TSVFormat      OutputFormat = "tsv"
+	NDJSONFormat   OutputFormat = "ndjson"
+	MarkdownFormat OutputFormat = "markdown"
+)
+
+// GoTemplatePrefix and JSONPathPrefix prefix the templates given as output format, e.g.
+// go-template={{range .items}}{{.name}}{{"\n"}}{{end}} or jsonpath={.items[*].name}.
+const (
+	GoTemplatePrefix = "go-template="
+	JSONPathPrefix   = "jsonpath="
+)
+
+// OutputFormats names of the supported output formats, other than the templates.
+var OutputFormats = []string{
+	string(TableFormat), string(WideFormat), string(JSONFormat), string(YAMLFormat),
+	string(CSVFormat), string(TSVFormat), string(NDJSONFormat), string(MarkdownFormat),
+}
+
+// IsTemplate checks if the output format is a go-template or a jsonpath template.
+func (f OutputFormat) IsTemplate() bool {
+	return strings.HasPrefix(string(f), GoTemplatePrefix) ||
+		strings.HasPrefix(string(f), JSONPathPrefix)
+}
+
+// Outputter displays data in an output format.
+// The data is either a dataframe.DataFrame, whose columns are displayed, or any struct or slice of
+// structs, whose fields are displayed with their JSON names.
+type Outputter interface {
+	Output(data any, out io.Writer) error
+}
+
+// NewOutputter returns the Outputter of the given format.
+func NewOutputter(format OutputFormat) (Outputter, error) {
+	switch format {
+	case TableFormat, WideFormat:
+		return tableOutputter{}, nil
+	case JSONFormat:
+		return jsonOutputter{}, nil
+	case YAMLFormat:
+		return yamlOutputter{}, nil
+	case CSVFormat:
+		return delimitedOutputter{separator: ','}, nil
+	case TSVFormat:
+		return delimitedOutputter{separator: '\t'}, nil
+	case NDJSONFormat:
+		return ndjsonOutputter{}, nil
+	case MarkdownFormat:
+		return markdownOutputter{}, nil
+	}
+
+	if strings.HasPrefix(string(format), GoTemplatePrefix) {
+		text := strings.TrimPrefix(string(format), GoTemplatePrefix)
+		tmpl, err := template.New("output").Parse(text)
+		if err != nil {
+			return nil, fmt.Errorf("invalid go-template: %v", err)
+		}
+		return goTemplateOutputter{template: tmpl}, nil
+	}
+	if strings.HasPrefix(string(format), JSONPathPrefix) {
+		text := strings.TrimPrefix(string(format), JSONPathPrefix)
+		path := jsonpath.New("output")
+		if err := path.Parse(text); err != nil {
+			return nil, fmt.Errorf("invalid jsonpath template: %v", err)
+		}
+		return jsonPathOutputter{path: path, text: text}, nil
+	}
+	return nil, fmt.Errorf(
+		"invalid output format '%s', must be one of '%s', or a %sTEMPLATE or %sTEMPLATE",
+		format, strings.Join(OutputFormats, "', '"), GoTemplatePrefix, JSONPathPrefix,
+	)
+}
+
+// DisplayOutput displays the data in the given output format, see Outputter.
+// Instead of writing to stdout, it uses the provided io.Writer.
+func DisplayOutput(data any, format OutputFormat, out io.Writer) error {
+	outputter, err := NewOutputter(format)
+	if err != nil {
+		return err
+	}
+	return outputter.Output(data, out)
+}
+
+// DisplayPayloadOutput displays the data in the given output format, like DisplayOutput, except
+// for the templates which are applied to the raw payload returned by the API instead, e.g.
+// operations.GetWorkflowsOKBody, so that they can refer to any of its fields.
+func DisplayPayloadOutput(data, payload any, format OutputFormat, out io.Writer) error {
+	if format.IsTemplate() {
+		data = payload
+	}
+	return DisplayOutput(data, format, out)
+}
+
+// tableOutputter displays the data as a table.
+type tableOutputter struct{}
+
+func (tableOutputter) Output(data any, out io.Writer) error {
+	header, rows, err := tabularData(data, "-")
+	if err != nil {
+		return err
+	}
+	DisplayTable(header, rows, out)
+	return nil
+}
+
+// jsonOutputter displays the data as an indented JSON document.
+type jsonOutputter struct{}
+
+func (jsonOutputter) Output(data any, out io.Writer) error {
+	return DisplayJsonOutput(structuredData(data), out)
+}
+
+// yamlOutputter displays the data as a YAML document.
+type yamlOutputter struct{}
+
+func (yamlOutputter) Output(data any, out io.Writer) error {
+	// the JSON names of the fields are used, as for the other formats
+	content, err := json.Marshal(structuredData(data))
+	if err != nil {
+		return fmt.Errorf("failed to display yaml output:\n%v", err)
+	}
+	var value any
+	if err := json.Unmarshal(content, &value); err != nil {
+		return fmt.Errorf("failed to display yaml output:\n%v", err)
+	}
+	encoder := yaml.NewEncoder(out)
+	encoder.SetIndent(2)
+	if err := encoder.Encode(value); err != nil {
+		return fmt.Errorf("failed to display yaml output:\n%v", err)
+	}
+	return encoder.Close()
+}
+
+// ndjsonOutputter displays each row, or each element of a slice, as a JSON document per line.
+type ndjsonOutputter struct{}
+
+func (ndjsonOutputter) Output(data any, out io.Writer) error {
+	encoder := json.NewEncoder(out)
+	for _, item := range items(structuredData(data)) {
+		if err := encoder.Encode(item); err != nil {
+			return fmt.Errorf("failed to display ndjson output:\n%v", err)
+		}
+	}
+	return nil
+}
+
+// delimitedOutputter displays the data as comma or tab separated values, with a header line.
+type delimitedOutputter struct {
+	separator rune
+}
+
+func (o delimitedOutputter) Output(data any, out io.Writer) error {
+	header, rows, err := tabularData(data, "")
+	if err != nil {
+		return err
+	}
+	writer := csv.NewWriter(out)
+	writer.Comma = o.separator
+	if err := writer.Write(header); err != nil {
+		return err
+	}
+	if err := writer.WriteAll(rows); err != nil {
+		return err
+	}
+	return writer.Error()
+}
+
+// markdownOutputter displays the data as a Markdown table.
+type markdownOutputter struct{}
+
+func (markdownOutputter) Output(data any, out io.Writer) error {
+	header, rows, err := tabularData(data, "")
+	if err != nil {
+		return err
+	}
+	separators := make([]string, len(header))
+	for i := range header {
+		separators[i] = "---"
+	}
+	writeMarkdownRow(out, header)
+	writeMarkdownRow(out, separators)
+	for _, row := range rows {
+		writeMarkdownRow(out, row)
+	}
+	return nil
+}
+
+func writeMarkdownRow(out io.Writer, cells []string) {
+	escaped := make([]string, len(cells))
+	for i, cell := range cells {
+		cell = strings.ReplaceAll(cell, "|", "\\|")
+		escaped[i] = strings.ReplaceAll(cell, "\n", "<br>")
+	}
+	fmt.Fprintf(out, "| %s |\n", strings.Join(escaped, " | "))
+}
+
+// goTemplateOutputter displays the data with a Go template, see text/template.
+type goTemplateOutputter struct {
+	template *template.Template
+}
+
+func (o goTemplateOutputter) Output(data any, out io.Writer) error {
+	value, err := templateData(data)
+	if err != nil {
+		return err
+	}
+	if err := o.template.Execute(out, value); err != nil {
+		return fmt.Errorf("failed to execute go-template: %v", err)
+	}
+	return nil
+}
+
+// jsonPathOutputter displays the data with a JSONPath template, see k8s.io/client-go/util/jsonpath.
+type jsonPathOutputter struct {
+	path *jsonpath.JSONPath
+	text string
+}
+
+func (o jsonPathOutputter) Output(data any, out io.Writer) error {
+	value, err := templateData(data)
+	if err != nil {
+		return err
+	}
+	// the output is buffered, so that nothing is displayed when the template fails
+	buf := new(bytes.Buffer)
+	if err := o.path.Execute(buf, value); err != nil {
+		return fmt.Errorf("failed to execute jsonpath template: %v", err)
+	}
+	if buf.Len() == 0 {
+		return fmt.Errorf("jsonpath template '%s' did not match anything", o.text)
+	}
+	_, err = buf.WriteTo(out)
+	return err
+}
+
+// templateData converts the data to the generic values decoded from its JSON representation, so
+// that the templates refer to the fields by their JSON names.
+func templateData(data any) (any, error) {
+	content, err := json.Marshal(structuredData(data))
+	if err != nil {
+		return nil, err
+	}
+	decoder := json.NewDecoder(bytes.NewReader(content))
+	// numbers are displayed as they are, instead of as floats
+	decoder.UseNumber()
+	var value any
+	if err := decoder.Decode(&value); err != nil {
+		return nil, err
+	}
+	return value, nil
+}
+
+// structuredData returns the records of a dataframe, indexed by column name, or the data as is.
+func structuredData(data any) any {
+	if df, ok := data.(dataframe.DataFrame); ok {
+		return df.Maps()
+	}
+	return data
+}
+
+// items returns the elements of a slice, or the data as the only element otherwise.
+func items(data any) []any {
+	value := reflect.ValueOf(data)
+	if value.Kind() != reflect.Slice {
+		return []any{data}
+	}
+	elements := make([]any, value.Len())
+	for i := range elements {
+		elements[i] = value.Index(i).Interface()
+	}
+	return elements
+}
+
+// tabularData returns the header and the rows of the data. Missing values are replaced by na.
+// The fields of structs nested in the data become columns named parent.field, and slices of
+// values are joined with commas.
+func tabularData(data any, na string) ([]string, [][]string, error) {
+	if df, ok := data.(dataframe.DataFrame); ok {
+		rows := formatter.DataFrameToStringData(df)
+		for i, row := range rows {
+			for j := range row {
+				if df.Elem(i, j).IsNA() {
+					rows[i][j] = na
+				}
+			}
+		}
+		return df.Names(), rows, nil
+	}
+
+	var header []string
+	var records []map[string]string
+	for _, item := range items(data) {
+		content, err := json.Marshal(item)
+		if err != nil {
+			return nil, nil, err
+		}
+		fields := orderedmap.New()
+		if err := json.Unmarshal(content, fields); err != nil {
+			return nil, nil, fmt.Errorf("cannot display %T as a table: %v", item, err)
+		}
+		record := map[string]string{}
+		for _, column := range flattenFields("", *fields, record, na) {
+			if !slices.Contains(header, column) {
+				header = append(header, column)
+			}
+		}
+		records = append(records, record)
+	}
+
+	rows := make([][]string, len(records))
+	for i, record := range records {
+		rows[i] = make([]string, len(header))
+		for j, column := range header {
+			value, ok := record[column]
+			if !ok {
+				value = na
+			}
+			rows[i][j] = value
+		}
+	}
+	return header, rows, nil
+}
+
+// flattenFields stores the fields in record, prefixing the names of nested fields with the name
+// of their parent. Returns the column names in the order of the fields.
+func flattenFields(
+	prefix string,
+	fields orderedmap.OrderedMap,
+	record map[string]string,
+	na string,
+) []string {
+	var columns []string
+	for _, key := range fields.Keys() {
+		value, _ := fields.Get(key)
+		column := prefix + key
+		if nested, ok := value.(orderedmap.OrderedMap); ok {
+			columns = append(columns, flattenFields(column+".", nested, record, na)...)
+			continue
+		}
+		record[column] = cellValue(value, na)
+		columns = append(columns, column)
+	}
+	return columns
+}
+
+// cellValue formats a JSON value as a table cell.
+func cellValue(value any, na string) string {
+	switch v := value.(type) {
+	case nil:
+		return na
+	case string:
+		return v
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case bool:
+		return strconv.FormatBool(v)
+	case []any:
+		values := make([]string, len(v))
+		for i, element := range v {
+			values[i] = cellValue(element, na)
+		}
+		return strings.Join(values, ", ")
+	}
+	content, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Sprint(value)
+	}
+	return string(content)
+}
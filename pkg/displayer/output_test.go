@@ -0,0 +1,197 @@
+/*
+This file is part of REANA.
+Copyright (C) 2022 CERN.
+
+REANA is free software; you can redistribute it and/or modify it
+under the terms of the MIT License; see LICENSE file for more details.
+*/
+
+package displayer
+
+import (
+	"bytes"
+	"reanahub/reana-client-go/client/operations"
+	"strings"
+	"testing"
+
+	"github.com/go-gota/gota/dataframe"
+	"github.com/go-gota/gota/series"
+)
+
+type testSize struct {
+	Raw           int    `json:"raw"`
+	HumanReadable string `json:"human_readable"`
+}
+
+type testFile struct {
+	Name string    `json:"name"`
+	Size *testSize `json:"size,omitempty"`
+	Tags []string  `json:"tags"`
+}
+
+func TestDisplayOutput(t *testing.T) {
+	df := dataframe.New(
+		series.New([]string{"a.txt", "b|c"}, series.String, "name"),
+		series.New([]any{1, nil}, series.Int, "size"),
+	)
+	files := []testFile{
+		{
+			Name: "a.txt",
+			Size: &testSize{Raw: 1024, HumanReadable: "1 KiB"},
+			Tags: []string{"x", "z"},
+		},
+		{Name: "b, c"},
+	}
+
+	payload := operations.GetWorkflowsOKBody{
+		Total: 2,
+		Items: []*operations.GetWorkflowsOKBodyItemsItems0{
+			{Name: "my_workflow.23", Status: "finished"},
+			{Name: "my_workflow2.12", Status: "running"},
+		},
+	}
+
+	tests := map[string]struct {
+		data     any
+		format   OutputFormat
+		expected string
+	}{
+		"dataframe table": {
+			data: df, format: TableFormat,
+			expected: "NAME    SIZE\na.txt   1\nb|c     -\n",
+		},
+		"dataframe json": {
+			data: df, format: JSONFormat,
+			expected: "[\n  {\n    \"name\": \"a.txt\",\n    \"size\": 1\n  },\n" +
+				"  {\n    \"name\": \"b|c\",\n    \"size\": null\n  }\n]\n",
+		},
+		"dataframe yaml": {
+			data: df, format: YAMLFormat,
+			expected: "- name: a.txt\n  size: 1\n- name: b|c\n  size: null\n",
+		},
+		"dataframe csv": {
+			data: df, format: CSVFormat,
+			expected: "name,size\na.txt,1\nb|c,\n",
+		},
+		"dataframe tsv": {
+			data: df, format: TSVFormat,
+			expected: "name\tsize\na.txt\t1\nb|c\t\n",
+		},
+		"dataframe ndjson": {
+			data: df, format: NDJSONFormat,
+			expected: "{\"name\":\"a.txt\",\"size\":1}\n{\"name\":\"b|c\",\"size\":null}\n",
+		},
+		"dataframe markdown": {
+			data: df, format: MarkdownFormat,
+			expected: "| name | size |\n| --- | --- |\n| a.txt | 1 |\n| b\\|c |  |\n",
+		},
+		"structs csv": {
+			data: files, format: CSVFormat,
+			expected: "name,size.raw,size.human_readable,tags\n" +
+				"a.txt,1024,1 KiB,\"x, z\"\n\"b, c\",,,\n",
+		},
+		"structs table": {
+			data: files, format: WideFormat,
+			expected: "NAME    SIZE.RAW   SIZE.HUMAN_READABLE   TAGS\n" +
+				"a.txt   1024       1 KiB                 x, z\n" +
+				"b, c    -          -                     -\n",
+		},
+		"struct yaml": {
+			data: files[0], format: YAMLFormat,
+			expected: "name: a.txt\nsize:\n  human_readable: 1 KiB\n  raw: 1024\n" +
+				"tags:\n  - x\n  - z\n",
+		},
+		"struct ndjson": {
+			data: files[1], format: NDJSONFormat,
+			expected: "{\"name\":\"b, c\",\"tags\":null}\n",
+		},
+		"dataframe go-template": {
+			data: df, format: `go-template={{range .}}{{.name}}:{{.size}}{{"\n"}}{{end}}`,
+			expected: "a.txt:1\nb|c:<no value>\n",
+		},
+		"dataframe jsonpath": {
+			data: df, format: `jsonpath={[*].name}`,
+			expected: "a.txt b|c",
+		},
+		"payload go-template": {
+			data: payload, format: `go-template={{.total}}{{range .items}} {{.name}}{{end}}`,
+			expected: "2 my_workflow.23 my_workflow2.12",
+		},
+		"payload jsonpath": {
+			data: payload, format: `jsonpath={.items[*].status}`,
+			expected: "finished running",
+		},
+		"struct jsonpath": {
+			data: files[0], format: `jsonpath={.size.raw} {.tags[0]}`,
+			expected: "1024 x",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			buf := new(bytes.Buffer)
+			if err := DisplayOutput(test.data, test.format, buf); err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+			// the table is padded up to the width of its columns
+			result := buf.String()
+			if test.format == TableFormat || test.format == WideFormat {
+				var lines []string
+				for _, line := range strings.Split(result, "\n") {
+					lines = append(lines, strings.TrimRight(line, " "))
+				}
+				result = strings.Join(lines, "\n")
+			}
+			if result != test.expected {
+				t.Errorf("Expected: '%s', got: '%s'", test.expected, result)
+			}
+		})
+	}
+}
+
+func TestNewOutputter(t *testing.T) {
+	for _, format := range OutputFormats {
+		if _, err := NewOutputter(OutputFormat(format)); err != nil {
+			t.Errorf("Unexpected error for format %s: %s", format, err)
+		}
+	}
+
+	for _, format := range []string{"go-template={{.name}}", "jsonpath={.name}"} {
+		if _, err := NewOutputter(OutputFormat(format)); err != nil {
+			t.Errorf("Unexpected error for format %s: %s", format, err)
+		}
+	}
+
+	errors := map[OutputFormat]string{
+		"xml":                 "invalid output format 'xml'",
+		"go-template={{.name": "invalid go-template",
+		"jsonpath={.items[}":  "invalid jsonpath template",
+	}
+	for format, expected := range errors {
+		_, err := NewOutputter(format)
+		if err == nil || !strings.Contains(err.Error(), expected) {
+			t.Errorf("Expected error containing '%s' for %s, got: %v", expected, format, err)
+		}
+	}
+}
+
+func TestJSONPathOutputErrors(t *testing.T) {
+	payload := map[string]any{"items": []map[string]string{}, "total": 0}
+	tests := map[string]string{
+		"jsonpath={.items[*].name}": "jsonpath template '{.items[*].name}' did not match anything",
+		"jsonpath={.workflows}":     "failed to execute jsonpath template: workflows is not found",
+	}
+
+	for format, expected := range tests {
+		t.Run(format, func(t *testing.T) {
+			buf := new(bytes.Buffer)
+			err := DisplayOutput(payload, OutputFormat(format), buf)
+			if err == nil || err.Error() != expected {
+				t.Errorf("Expected error '%s', got: %v", expected, err)
+			}
+			if buf.Len() != 0 {
+				t.Errorf("Expected no output, got: '%s'", buf.String())
+			}
+		})
+	}
+}
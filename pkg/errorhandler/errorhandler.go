@@ -10,6 +10,7 @@ under the terms of the MIT License; see LICENSE file for more details.
 package errorhandler
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"net/url"
@@ -18,11 +19,45 @@ import (
 	"github.com/spf13/viper"
 )
 
+// ExitError is an error terminating the client with a specific exit code, e.g. to report the
+// outcome of a workflow to scripts. Err is displayed as any other error.
+type ExitError struct {
+	Code int
+	Err  error
+}
+
+func (e *ExitError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *ExitError) Unwrap() error {
+	return e.Err
+}
+
+// ExitCode returns the exit code of the client for the given error, 1 unless it is an ExitError.
+func ExitCode(err error) int {
+	var exitErr *ExitError
+	if errors.As(err, &exitErr) {
+		return exitErr.Code
+	}
+	return 1
+}
+
 // HandleApiError Handles API Error response which contains a payload with a message
 // Returns the original error when this doesn't happen
 func HandleApiError(err error) error {
-	_, isUrlErr := err.(*url.Error)
-	if isUrlErr {
+	if errors.Is(err, context.Canceled) {
+		return errors.New("operation interrupted, exiting")
+	}
+	var urlErr *url.Error
+	if errors.Is(err, context.DeadlineExceeded) || (errors.As(err, &urlErr) && urlErr.Timeout()) {
+		return fmt.Errorf(
+			"request to '%s' timed out, please retry or increase the value of --timeout",
+			viper.GetString("server-url"),
+		)
+	}
+
+	if errors.As(err, &urlErr) {
 		return fmt.Errorf(
 			"'%s' not found, please verify the provided server URL or check your internet connection",
 			viper.GetString("server-url"),
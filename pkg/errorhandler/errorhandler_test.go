@@ -9,6 +9,7 @@ under the terms of the MIT License; see LICENSE file for more details.
 package errorhandler
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"net/url"
@@ -23,6 +24,12 @@ type testApiError struct {
 
 func (e *testApiError) Error() string { return e.Payload.Message }
 
+// testTimeoutError represents a network timeout, e.g. while waiting for the response headers.
+type testTimeoutError struct{}
+
+func (testTimeoutError) Error() string { return "timeout awaiting response headers" }
+func (testTimeoutError) Timeout() bool { return true }
+
 func TestHandleApiError(t *testing.T) {
 	serverURL := "https://localhost:8080"
 	viper.Set("server-url", serverURL)
@@ -45,6 +52,24 @@ func TestHandleApiError(t *testing.T) {
 				serverURL,
 			),
 		},
+		"interrupted": {
+			arg:  fmt.Errorf("wrapped: %w", context.Canceled),
+			want: "operation interrupted, exiting",
+		},
+		"timeout": {
+			arg: &url.Error{Op: "Get", URL: serverURL, Err: context.DeadlineExceeded},
+			want: fmt.Sprintf(
+				"request to '%s' timed out, please retry or increase the value of --timeout",
+				serverURL,
+			),
+		},
+		"response header timeout": {
+			arg: &url.Error{Op: "Get", URL: serverURL, Err: testTimeoutError{}},
+			want: fmt.Sprintf(
+				"request to '%s' timed out, please retry or increase the value of --timeout",
+				serverURL,
+			),
+		},
 		"api error": {
 			arg:  &apiError,
 			want: apiError.Error(),
@@ -63,3 +88,21 @@ func TestHandleApiError(t *testing.T) {
 		})
 	}
 }
+
+func TestExitCode(t *testing.T) {
+	tests := map[string]struct {
+		arg  error
+		want int
+	}{
+		"other error": {arg: errors.New("other error"), want: 1},
+		"exit error":  {arg: &ExitError{Code: 3, Err: errors.New("stopped")}, want: 3},
+		"wrapped":     {arg: fmt.Errorf("wrapped: %w", &ExitError{Code: 124}), want: 124},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			if got := ExitCode(test.arg); got != test.want {
+				t.Errorf("Expected %d, got %d", test.want, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,274 @@
+/*
+This file is part of REANA.
+Copyright (C) 2022 CERN.
+
+REANA is free software; you can redistribute it and/or modify it
+under the terms of the MIT License; see LICENSE file for more details.
+*/
+
+package filterer
+
+import (
+	"errors"
+	"fmt"
+	"path"
+	"reanahub/reana-client-go/pkg/validator"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-gota/gota/dataframe"
+	"github.com/go-gota/gota/series"
+)
+
+// Operator compares the values of a column with the value of a filter.
+type Operator string
+
+const (
+	Equal          Operator = "="
+	NotEqual       Operator = "!="
+	Greater        Operator = ">"
+	GreaterOrEqual Operator = ">="
+	Less           Operator = "<"
+	LessOrEqual    Operator = "<="
+	Matches        Operator = "~"
+	NotMatches     Operator = "!~"
+)
+
+// operators supported operators, the longest ones first so that they are parsed first.
+var operators = []Operator{
+	NotEqual, GreaterOrEqual, LessOrEqual, NotMatches, Equal, Greater, Less, Matches,
+}
+
+// globChars characters making the value of an equality filter a glob pattern, see path.Match.
+const globChars = "*?["
+
+// sizeUnits multipliers of the size units, e.g. 10MB or 1.5 GiB.
+var sizeUnits = map[string]float64{
+	"b": 1, "byte": 1, "bytes": 1,
+	"kb": 1e3, "mb": 1e6, "gb": 1e9, "tb": 1e12, "pb": 1e15,
+	"kib": 1 << 10, "mib": 1 << 20, "gib": 1 << 30, "tib": 1 << 40, "pib": 1 << 50,
+}
+
+// durationUnits multipliers, in seconds, of the duration units, e.g. 30m or 7d.
+var durationUnits = map[string]float64{
+	"s": 1, "m": 60, "h": 3600, "d": 24 * 3600, "w": 7 * 24 * 3600,
+}
+
+// quantityPattern matches a number followed by an optional unit.
+var quantityPattern = regexp.MustCompile(`^([-+]?[0-9]*\.?[0-9]+)\s*([a-zA-Z]*)$`)
+
+// timeLayouts layouts of the dates accepted by the filters, the fractional seconds being optional.
+var timeLayouts = []string{
+	time.RFC3339, "2006-01-02T15:04:05", "2006-01-02 15:04:05", "2006-01-02T15:04", "2006-01-02",
+}
+
+// now returns the current time, relative dates such as -7d being computed from it.
+var now = time.Now
+
+// Expression is a filter in the format 'key<operator>value', e.g. 'size>10MB' or 'name~^test'.
+//
+// The values are compared as numbers when both of them are numbers, sizes (e.g. 10MB or 2 GiB)
+// or durations (e.g. 30m or 7d), as dates when both of them are dates (e.g. 2026-01-01, or -7d for
+// seven days ago), and as strings otherwise. The value of an equality is a glob pattern when it
+// contains any of '*?['. Matches compares with a regular expression.
+type Expression struct {
+	Key      string
+	Operator Operator
+	Value    string
+	pattern  *regexp.Regexp
+}
+
+// NewExpression returns a new Expression, after validating its value.
+func NewExpression(key string, operator Operator, value string) (Expression, error) {
+	expression := Expression{Key: key, Operator: operator, Value: value}
+	switch operator {
+	case Matches, NotMatches:
+		pattern, err := regexp.Compile(value)
+		if err != nil {
+			return expression, fmt.Errorf("invalid regular expression for '%s': %v", key, err)
+		}
+		expression.pattern = pattern
+	case Equal, NotEqual:
+		if !expression.IsGlob() {
+			break
+		}
+		if _, err := path.Match(value, ""); err != nil {
+			return expression, fmt.Errorf("invalid pattern for '%s': %v", key, err)
+		}
+	case Greater, GreaterOrEqual, Less, LessOrEqual:
+	default:
+		return expression, fmt.Errorf("invalid operator '%s'", operator)
+	}
+	return expression, nil
+}
+
+// ParseExpression parses a filter in the format 'key<operator>value', see Expression.
+func ParseExpression(filter string) (Expression, error) {
+	key, operator, value, err := SplitExpression(filter)
+	if err != nil {
+		return Expression{}, err
+	}
+	return NewExpression(key, operator, value)
+}
+
+// SplitExpression splits a filter in the format 'key<operator>value' in its key, operator and
+// value, without validating them. The operator is the first one found in the filter.
+func SplitExpression(filter string) (string, Operator, string, error) {
+	index := strings.IndexAny(filter, "=!<>~")
+	if index == -1 {
+		return "", "", "", errors.New("wrong input format. Please use key=value")
+	}
+	for _, operator := range operators {
+		if strings.HasPrefix(filter[index:], string(operator)) {
+			value := filter[index+len(operator):]
+			return filter[:index], operator, value, nil
+		}
+	}
+	return "", "", "", fmt.Errorf("invalid operator in '%s'", filter)
+}
+
+// IsGlob checks if the expression is an equality, or inequality, with a glob pattern.
+func (e Expression) IsGlob() bool {
+	return (e.Operator == Equal || e.Operator == NotEqual) &&
+		strings.ContainsAny(e.Value, globChars)
+}
+
+// Match checks if the given value satisfies the expression.
+func (e Expression) Match(value string) bool {
+	switch e.Operator {
+	case Matches:
+		return e.pattern.MatchString(value)
+	case NotMatches:
+		return !e.pattern.MatchString(value)
+	case Equal, NotEqual:
+		equal := compareValues(value, e.Value) == 0
+		if e.IsGlob() {
+			equal, _ = path.Match(e.Value, value)
+		}
+		return equal == (e.Operator == Equal)
+	case Greater:
+		return compareValues(value, e.Value) > 0
+	case GreaterOrEqual:
+		return compareValues(value, e.Value) >= 0
+	case Less:
+		return compareValues(value, e.Value) < 0
+	case LessOrEqual:
+		return compareValues(value, e.Value) <= 0
+	}
+	return false
+}
+
+// FilterDataFrame keeps the rows of the dataframe matching all the expressions.
+// Missing values only match the negated expressions.
+func FilterDataFrame(
+	df dataframe.DataFrame,
+	expressions []Expression,
+) (dataframe.DataFrame, error) {
+	for _, expression := range expressions {
+		err := validator.ValidateChoice(expression.Key, df.Names(), "filter column")
+		if err != nil {
+			return df, err
+		}
+		expression := expression
+		df = df.Filter(dataframe.F{
+			Colname:    expression.Key,
+			Comparator: series.CompFunc,
+			Comparando: func(element series.Element) bool {
+				if element.IsNA() {
+					return expression.Operator == NotEqual || expression.Operator == NotMatches
+				}
+				return expression.Match(element.String())
+			},
+		})
+		if df.Err != nil {
+			return df, df.Err
+		}
+	}
+	return df, nil
+}
+
+// compareValues compares two values as numbers, sizes or durations, then as dates, and as strings
+// otherwise. Returns -1, 0 or 1 if a is less than, equal to or greater than b, respectively.
+func compareValues(a, b string) int {
+	if x, ok := parseQuantity(a); ok {
+		if y, ok := parseQuantity(b); ok {
+			return compareOrdered(x, y)
+		}
+	}
+	if x, ok := parseTime(a); ok {
+		if y, ok := parseTime(b); ok {
+			if x.Equal(y) {
+				return 0
+			}
+			if x.Before(y) {
+				return -1
+			}
+			return 1
+		}
+	}
+	return strings.Compare(a, b)
+}
+
+// compareOrdered compares two numbers, see compareValues.
+func compareOrdered(a, b float64) int {
+	if a < b {
+		return -1
+	}
+	if a > b {
+		return 1
+	}
+	return 0
+}
+
+// parseQuantity parses a number, followed by an optional size or duration unit, e.g. 10MB or 7d.
+// Sizes are converted to bytes and durations to seconds.
+func parseQuantity(value string) (float64, bool) {
+	match := quantityPattern.FindStringSubmatch(strings.TrimSpace(value))
+	if match == nil {
+		return 0, false
+	}
+	number, err := strconv.ParseFloat(match[1], 64)
+	if err != nil {
+		return 0, false
+	}
+	unit := strings.ToLower(match[2])
+	if unit == "" {
+		return number, true
+	}
+	if multiplier, ok := sizeUnits[unit]; ok {
+		return number * multiplier, true
+	}
+	if multiplier, ok := durationUnits[unit]; ok {
+		return number * multiplier, true
+	}
+	return 0, false
+}
+
+// parseTime parses a date, see timeLayouts, or a duration relative to now, e.g. -7d.
+// Dates without a time zone are considered to be in UTC, as the ones returned by the server.
+func parseTime(value string) (time.Time, bool) {
+	if strings.HasPrefix(value, "-") {
+		match := quantityPattern.FindStringSubmatch(value[1:])
+		if match == nil {
+			return time.Time{}, false
+		}
+		multiplier, ok := durationUnits[strings.ToLower(match[2])]
+		if !ok {
+			return time.Time{}, false
+		}
+		number, err := strconv.ParseFloat(match[1], 64)
+		if err != nil {
+			return time.Time{}, false
+		}
+		seconds := time.Duration(number * multiplier * float64(time.Second))
+		return now().UTC().Add(-seconds), true
+	}
+	for _, layout := range timeLayouts {
+		if t, err := time.Parse(layout, value); err == nil {
+			return t, true
+		}
+	}
+	return time.Time{}, false
+}
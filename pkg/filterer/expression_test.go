@@ -0,0 +1,161 @@
+/*
+This file is part of REANA.
+Copyright (C) 2022 CERN.
+
+REANA is free software; you can redistribute it and/or modify it
+under the terms of the MIT License; see LICENSE file for more details.
+*/
+
+package filterer
+
+import (
+	"testing"
+	"time"
+
+	"github.com/go-gota/gota/dataframe"
+	"github.com/go-gota/gota/series"
+	"golang.org/x/exp/slices"
+)
+
+func TestSplitExpression(t *testing.T) {
+	tests := map[string]struct {
+		filter    string
+		key       string
+		operator  Operator
+		value     string
+		wantError bool
+	}{
+		"equal":             {filter: "name=test", key: "name", operator: Equal, value: "test"},
+		"not equal":         {filter: "name!=test", key: "name", operator: NotEqual, value: "test"},
+		"greater":           {filter: "size>10MB", key: "size", operator: Greater, value: "10MB"},
+		"greater or equal":  {filter: "size>=1", key: "size", operator: GreaterOrEqual, value: "1"},
+		"less":              {filter: "created<-7d", key: "created", operator: Less, value: "-7d"},
+		"less or equal":     {filter: "run<=2", key: "run", operator: LessOrEqual, value: "2"},
+		"matches":           {filter: "name~^a", key: "name", operator: Matches, value: "^a"},
+		"not matches":       {filter: "name!~^a", key: "name", operator: NotMatches, value: "^a"},
+		"operator in value": {filter: "name=a<b", key: "name", operator: Equal, value: "a<b"},
+		"missing operator":  {filter: "name", wantError: true},
+		"invalid operator":  {filter: "name!test", wantError: true},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			key, operator, value, err := SplitExpression(test.filter)
+			if test.wantError {
+				if err == nil {
+					t.Errorf("Expected error for SplitExpression(%s), got nil", test.filter)
+				}
+			} else if err != nil {
+				t.Errorf("Unexpected error for SplitExpression(%s): '%s'", test.filter, err)
+			} else if key != test.key || operator != test.operator || value != test.value {
+				t.Errorf(
+					"Expected %s,%s,%s, got %s,%s,%s",
+					test.key, test.operator, test.value, key, operator, value,
+				)
+			}
+		})
+	}
+}
+
+func TestExpressionMatch(t *testing.T) {
+	now = func() time.Time {
+		return time.Date(2026, 10, 16, 12, 0, 0, 0, time.UTC)
+	}
+	defer func() { now = time.Now }()
+
+	tests := map[string]struct {
+		filter   string
+		value    string
+		expected bool
+	}{
+		"equal":          {filter: "status=failed", value: "failed", expected: true},
+		"different":      {filter: "status=failed", value: "finished", expected: false},
+		"not equal":      {filter: "status!=failed", value: "finished", expected: true},
+		"numbers":        {filter: "run=2", value: "2.0", expected: true},
+		"greater":        {filter: "run>9", value: "10", expected: true},
+		"lesser":         {filter: "run<9", value: "10", expected: false},
+		"size":           {filter: "size>10MB", value: "10000001", expected: true},
+		"equal size":     {filter: "size>=1KiB", value: "1024", expected: true},
+		"readable size":  {filter: "size<2MiB", value: "1.89 MiB", expected: true},
+		"duration":       {filter: "duration>1h", value: "3601", expected: true},
+		"short":          {filter: "duration>1h", value: "60", expected: false},
+		"date":           {filter: "c>2026-01-01", value: "2026-03-01T10:00:00", expected: true},
+		"earlier date":   {filter: "c>2026-01-01", value: "2025-12-31T23:59:59", expected: false},
+		"relative":       {filter: "c>-7d", value: "2026-10-10T10:00:00", expected: true},
+		"older":          {filter: "c>-7d", value: "2026-10-01T10:00:00", expected: false},
+		"fraction":       {filter: "c<=2026-10-16", value: "2026-10-15T23:59:59.5", expected: true},
+		"strings":        {filter: "name>b", value: "c", expected: true},
+		"glob":           {filter: "name=test*", value: "test_workflow", expected: true},
+		"glob mismatch":  {filter: "name=test*", value: "my_test", expected: false},
+		"negated glob":   {filter: "name!=test?", value: "test12", expected: true},
+		"regex":          {filter: "name~^my_.*[0-9]$", value: "my_flow2", expected: true},
+		"regex mismatch": {filter: "name~^my_.*[0-9]$", value: "my_flow", expected: false},
+		"negated regex":  {filter: "name!~^my_", value: "workflow", expected: true},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			expression, err := ParseExpression(test.filter)
+			if err != nil {
+				t.Fatalf("Unexpected error: '%s'", err)
+			}
+			if result := expression.Match(test.value); result != test.expected {
+				t.Errorf(
+					"Expected %s to match %s: %t, got %t",
+					test.filter, test.value, test.expected, result,
+				)
+			}
+		})
+	}
+}
+
+func TestFilterDataFrame(t *testing.T) {
+	df := dataframe.New(
+		series.New([]string{"a.txt", "b.csv", "c.txt"}, series.String, "name"),
+		series.New([]any{2048, 10, nil}, series.Int, "size"),
+	)
+
+	tests := map[string]struct {
+		filters   []string
+		expected  []string
+		wantError bool
+	}{
+		"no filters":    {expected: []string{"a.txt", "b.csv", "c.txt"}},
+		"size":          {filters: []string{"size>1KB"}, expected: []string{"a.txt"}},
+		"missing value": {filters: []string{"size!=10"}, expected: []string{"a.txt", "c.txt"}},
+		"glob and size": {
+			filters:  []string{"name=*.txt", "size<1MB"},
+			expected: []string{"a.txt"},
+		},
+		"no match":       {filters: []string{"name~^d"}, expected: []string{}},
+		"invalid column": {filters: []string{"created>-7d"}, wantError: true},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			var expressions []Expression
+			for _, filter := range test.filters {
+				expression, err := ParseExpression(filter)
+				if err != nil {
+					t.Fatalf("Unexpected error: '%s'", err)
+				}
+				expressions = append(expressions, expression)
+			}
+
+			result, err := FilterDataFrame(df, expressions)
+			if test.wantError {
+				if err == nil {
+					t.Errorf("Expected error for FilterDataFrame(%v), got nil", test.filters)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Unexpected error: '%s'", err)
+			}
+			names := result.Col("name").Records()
+			if !slices.Equal(names, test.expected) {
+				t.Errorf("Expected rows %v, got %v", test.expected, names)
+			}
+		})
+	}
+}
@@ -13,7 +13,6 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
-	"reanahub/reana-client-go/pkg/datautils"
 	"strings"
 
 	"golang.org/x/exp/slices"
@@ -23,16 +22,28 @@ import (
 type Filters struct {
 	SingleFilterKeys   []string // names (keys) of the single value filters to be considered
 	MultiFilterKeys    []string // names (keys) of the multi value filters to be considered
+	ClientFilterKeys   []string // names (keys) of the filters that can be applied client-side
 	singleValueFilters map[string]string
 	multiValueFilters  map[string][]string
+	clientFilters      []Expression
 }
 
 // NewFilters returns a new instance of Filters, with the given keys.
 // singleFilterKeys are the filters with only one value at a time, while multiFilterKeys can accumulate values.
 func NewFilters(singleFilterKeys, multiFilterKeys, inputFilters []string) (Filters, error) {
+	return NewClientFilters(singleFilterKeys, multiFilterKeys, nil, inputFilters)
+}
+
+// NewClientFilters returns a new instance of Filters, like NewFilters, which also accepts the
+// filters on clientFilterKeys and the filters with other operators than '=', see Expression.
+// The filters that cannot be sent to the server are returned by GetClientFilters.
+func NewClientFilters(
+	singleFilterKeys, multiFilterKeys, clientFilterKeys, inputFilters []string,
+) (Filters, error) {
 	filters := Filters{
 		SingleFilterKeys:   singleFilterKeys,
 		MultiFilterKeys:    multiFilterKeys,
+		ClientFilterKeys:   clientFilterKeys,
 		singleValueFilters: make(map[string]string),
 		multiValueFilters:  make(map[string][]string),
 	}
@@ -55,21 +66,30 @@ func (f *Filters) AddFilters(filters []string) error {
 }
 
 // AddFilter adds a filter, in the format 'key=value'. Works for both single and multi value filters.
+// The filters with other operators, e.g. 'key>value', or with glob patterns are client filters.
 func (f *Filters) AddFilter(filter string) error {
-	key, value, err := f.getKeyAndValue(filter)
+	expression, err := f.parseExpression(filter)
 	if err != nil {
 		return err
 	}
 
-	if slices.Contains(f.SingleFilterKeys, key) {
+	key, value := expression.Key, expression.Value
+	serverSide := expression.Operator == Equal && !expression.IsGlob()
+	if serverSide && slices.Contains(f.SingleFilterKeys, key) {
 		f.singleValueFilters[key] = value
-	} else if slices.Contains(f.MultiFilterKeys, key) {
+	} else if serverSide && slices.Contains(f.MultiFilterKeys, key) {
 		f.multiValueFilters[key] = append(f.multiValueFilters[key], value)
+	} else if slices.Contains(f.ClientFilterKeys, key) {
+		f.clientFilters = append(f.clientFilters, expression)
+	} else if slices.Contains(f.SingleFilterKeys, key) || slices.Contains(f.MultiFilterKeys, key) {
+		return fmt.Errorf(
+			"filter key '%s' only supports exact values, in the format '%s=value'", key, key,
+		)
 	} else {
 		return fmt.Errorf(
 			"filter key '%s' is not valid\nAvailable filters are '%s'",
 			key,
-			strings.Join(append(f.SingleFilterKeys, f.MultiFilterKeys...), "', '"),
+			strings.Join(f.keys(), "', '"),
 		)
 	}
 	return nil
@@ -101,6 +121,11 @@ func (f Filters) GetMulti(key string) ([]string, error) {
 	return f.multiValueFilters[key], nil
 }
 
+// GetClientFilters returns the filters to be applied client-side, see FilterDataFrame.
+func (f Filters) GetClientFilters() []Expression {
+	return f.clientFilters
+}
+
 // GetJson gets a JSON string with the filters specified in keys.
 func (f Filters) GetJson(keys []string) (string, error) {
 	jsonMap := make(map[string]any)
@@ -148,10 +173,13 @@ func (f Filters) ValidateValues(key string, possibleValues []string) error {
 				strings.Join(possibleValues, "', '"),
 			)
 		}
-	} else if slices.Contains(f.MultiFilterKeys, key) {
-		values, exists := f.multiValueFilters[key]
-		if !exists {
-			return nil
+	} else if slices.Contains(f.MultiFilterKeys, key) || slices.Contains(f.ClientFilterKeys, key) {
+		values := f.multiValueFilters[key]
+		for _, expression := range f.clientFilters {
+			if expression.Key == key && !expression.IsGlob() &&
+				(expression.Operator == Equal || expression.Operator == NotEqual) {
+				values = append(values, expression.Value)
+			}
 		}
 		for _, value := range values {
 			if !slices.Contains(possibleValues, value) {
@@ -172,14 +200,28 @@ func (f Filters) ValidateValues(key string, possibleValues []string) error {
 	return nil
 }
 
-// getKeyAndValue parses a filter in the format 'filter=value' and returns them.
-func (f Filters) getKeyAndValue(filter string) (string, string, error) {
-	filterName, filterValue, err := datautils.SplitKeyValue(filter)
+// parseExpression parses a filter in the format 'filter=value', or with another operator, and
+// returns it.
+func (f Filters) parseExpression(filter string) (Expression, error) {
+	filterName, operator, filterValue, err := SplitExpression(filter)
 	if err != nil {
-		return "", "", errors.New(
+		return Expression{}, errors.New(
 			"wrong input format. Please use --filter filter_name=filter_value",
 		)
 	}
 	filterName = strings.ToLower(filterName)
-	return filterName, filterValue, nil
+	return NewExpression(filterName, operator, filterValue)
+}
+
+// keys returns the names of all the available filters.
+func (f Filters) keys() []string {
+	var keys []string
+	for _, group := range [][]string{f.SingleFilterKeys, f.MultiFilterKeys, f.ClientFilterKeys} {
+		for _, key := range group {
+			if !slices.Contains(keys, key) {
+				keys = append(keys, key)
+			}
+		}
+	}
+	return keys
 }
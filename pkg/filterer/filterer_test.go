@@ -457,19 +457,27 @@ func TestFiltersValidateValues(t *testing.T) {
 	}
 }
 
-func TestFiltersGetKeyAndValue(t *testing.T) {
+func TestFiltersParseExpression(t *testing.T) {
 	tests := map[string]struct {
 		filter    string
 		name      string
+		operator  Operator
 		value     string
 		wantError bool
 	}{
-		"regular filter":      {filter: "key=value", name: "key", value: "value"},
-		"missing value":       {filter: "key=", name: "key", value: ""},
-		"missing key":         {filter: "=value", name: "", value: "value"},
-		"uppercase key":       {filter: "KEY=value", name: "key", value: "value"},
-		"value including '='": {filter: "key=value=value", name: "key", value: "value=value"},
-		"invalid input":       {filter: "invalid", wantError: true},
+		"regular filter": {filter: "key=value", name: "key", operator: Equal, value: "value"},
+		"missing value":  {filter: "key=", name: "key", operator: Equal, value: ""},
+		"missing key":    {filter: "=value", name: "", operator: Equal, value: "value"},
+		"uppercase key":  {filter: "KEY=value", name: "key", operator: Equal, value: "value"},
+		"value including '='": {
+			filter: "key=value=value", name: "key", operator: Equal, value: "value=value",
+		},
+		"not equal":        {filter: "key!=value", name: "key", operator: NotEqual, value: "value"},
+		"greater or equal": {filter: "key>=1", name: "key", operator: GreaterOrEqual, value: "1"},
+		"regex":            {filter: "key~^a.*", name: "key", operator: Matches, value: "^a.*"},
+		"invalid input":    {filter: "invalid", wantError: true},
+		"invalid regex":    {filter: "key~(", wantError: true},
+		"invalid glob":     {filter: "key=[", wantError: true},
 	}
 
 	for name, test := range tests {
@@ -479,16 +487,96 @@ func TestFiltersGetKeyAndValue(t *testing.T) {
 				t.Fatalf("Unexpected error when creating filters: '%s'", err.Error())
 			}
 
-			name, value, err := filters.getKeyAndValue(test.filter)
+			expression, err := filters.parseExpression(test.filter)
 			if test.wantError {
 				if err == nil {
-					t.Errorf("Expected error for GetKeyAndValue(%s), got nil", test.filter)
+					t.Errorf("Expected error for parseExpression(%s), got nil", test.filter)
 				}
 			} else if err != nil {
-				t.Errorf("Unexpected error for GetKeyAndValue(%s): '%s'", test.filter, err.Error())
-			} else if name != test.name || value != test.value {
-				t.Errorf("Expected result to be %s,%s, got %s,%s", test.name, test.value, name, value)
+				t.Errorf("Unexpected error for parseExpression(%s): '%s'", test.filter, err.Error())
+			} else if expression.Key != test.name || expression.Operator != test.operator ||
+				expression.Value != test.value {
+				t.Errorf(
+					"Expected result to be %s,%s,%s, got %s,%s,%s",
+					test.name, test.operator, test.value,
+					expression.Key, expression.Operator, expression.Value,
+				)
 			}
 		})
 	}
 }
+
+func TestFiltersClientFilters(t *testing.T) {
+	tests := map[string]struct {
+		inputFilters  []string
+		serverFilters []string
+		clientFilters []string
+		wantError     bool
+	}{
+		"server filters": {
+			inputFilters:  []string{"name=test", "status=finished"},
+			serverFilters: []string{"test", "finished"},
+		},
+		"client filters": {
+			inputFilters:  []string{"status!=failed", "name=test*", "size>10MB"},
+			clientFilters: []string{"status!=failed", "name=test*", "size>10MB"},
+		},
+		"client filter on server key": {
+			inputFilters:  []string{"name=test", "created>-7d"},
+			serverFilters: []string{"test"},
+			clientFilters: []string{"created>-7d"},
+		},
+		"invalid key": {
+			inputFilters: []string{"invalid>1"},
+			wantError:    true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			filters, err := NewClientFilters(
+				nil,
+				[]string{"name", "status"},
+				[]string{"name", "status", "size", "created"},
+				test.inputFilters,
+			)
+			if test.wantError {
+				if err == nil {
+					t.Errorf("Expected error for NewClientFilters(%v), got nil", test.inputFilters)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Unexpected error: '%s'", err.Error())
+			}
+
+			var serverFilters []string
+			for _, key := range filters.MultiFilterKeys {
+				values, _ := filters.GetMulti(key)
+				serverFilters = append(serverFilters, values...)
+			}
+			if !slices.Equal(serverFilters, test.serverFilters) {
+				t.Errorf("Expected server filters %v, got %v", test.serverFilters, serverFilters)
+			}
+
+			var clientFilters []string
+			for _, expression := range filters.GetClientFilters() {
+				clientFilters = append(
+					clientFilters,
+					expression.Key+string(expression.Operator)+expression.Value,
+				)
+			}
+			if !slices.Equal(clientFilters, test.clientFilters) {
+				t.Errorf("Expected client filters %v, got %v", test.clientFilters, clientFilters)
+			}
+		})
+	}
+}
+
+func TestFiltersOperatorOnServerKey(t *testing.T) {
+	_, err := NewFilters(nil, []string{"name"}, []string{"name!=test"})
+	expected := "filter key 'name' only supports exact values, in the format 'name=value'"
+	if err == nil || err.Error() != expected {
+		t.Errorf("Expected error '%s', got: %v", expected, err)
+	}
+}
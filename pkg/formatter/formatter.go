@@ -11,7 +11,9 @@ package formatter
 
 import (
 	"fmt"
+	"reanahub/reana-client-go/pkg/filterer"
 	"reanahub/reana-client-go/pkg/validator"
+	"regexp"
 	"strings"
 
 	"github.com/go-gota/gota/dataframe"
@@ -19,22 +21,42 @@ import (
 	"golang.org/x/exp/slices"
 )
 
+// RedactedToken replaces the access tokens that are not displayed.
+const RedactedToken = "REDACTED"
+
+// tokenParamPattern matches the access tokens passed as query parameters.
+var tokenParamPattern = regexp.MustCompile(`\b((?:access_)?token=)[^&\s"']+`)
+
+// tokenFieldPatterns match the access tokens in JSON documents, e.g. in the logged response bodies:
+// the access_token and token fields, and the value of the reana_token object returned by /api/you.
+var tokenFieldPatterns = []*regexp.Regexp{
+	regexp.MustCompile(`("(?:access_)?token"\s*:\s*")(?:[^"\\]|\\.)*"`),
+	regexp.MustCompile(`("reana_token"\s*:\s*\{[^{}]*?"value"\s*:\s*")(?:[^"\\]|\\.)*"`),
+}
+
 // FormatFilter provides a centralized way of handling format options across the different commands.
 type FormatFilter struct {
 	column     string
+	operator   filterer.Operator // see filterer.Expression, '=' if not set.
 	value      string
 	filterRows bool // set to true if a value was provided and the rows should be filtered by this column.
 }
 
 // ParseFormatParameters parses a list of formatOptions to a slice of FormatFilter.
 // If the format option has a filter, that will be the value in the struct and the filterRows boolean will be true.
+// Besides 'column=value', the filters support the operators of filterer.Expression, e.g. size>1MB.
 func ParseFormatParameters(formatOptions []string, filterRows bool) []FormatFilter {
 	var parsedFilters []FormatFilter
 	for _, filter := range formatOptions {
-		filterNameAndValue := strings.SplitN(filter, "=", 2)
-		formatFilter := FormatFilter{column: filterNameAndValue[0], filterRows: false}
-		if filterRows && len(filterNameAndValue) >= 2 {
-			formatFilter.value = filterNameAndValue[1]
+		column, operator, value, err := filterer.SplitExpression(filter)
+		if err != nil {
+			parsedFilters = append(parsedFilters, FormatFilter{column: filter, filterRows: false})
+			continue
+		}
+		formatFilter := FormatFilter{column: column, filterRows: false}
+		if filterRows {
+			formatFilter.operator = operator
+			formatFilter.value = value
 			formatFilter.filterRows = true
 		}
 		parsedFilters = append(parsedFilters, formatFilter)
@@ -53,22 +75,28 @@ func FormatDataFrame(
 	}
 
 	var newCols []series.Series
+	var expressions []filterer.Expression
 	for _, filter := range formatFilters {
 		if err := validator.ValidateChoice(filter.column, df.Names(), "format column"); err != nil {
 			return df, err
 		}
 		newCols = append(newCols, df.Col(filter.column))
-	}
-	df = dataframe.New(newCols...)
 
-	for _, filter := range formatFilters {
 		if filter.filterRows {
-			df = df.Filter(dataframe.F{
-				Colname: filter.column, Comparator: series.Eq, Comparando: filter.value,
-			})
+			operator := filter.operator
+			if operator == "" {
+				operator = filterer.Equal
+			}
+			expression, err := filterer.NewExpression(filter.column, operator, filter.value)
+			if err != nil {
+				return df, err
+			}
+			expressions = append(expressions, expression)
 		}
 	}
-	return df, nil
+	df = dataframe.New(newCols...)
+
+	return filterer.FilterDataFrame(df, expressions)
 }
 
 // SortDataFrame sorts the given dataFrame according to the sortColumn and whether the order is reversed.
@@ -101,6 +129,53 @@ func DataFrameToStringData(df dataframe.DataFrame) [][]string {
 }
 
 // FormatSessionURI takes the serverURL, its token and a path, and formats them into a session URI.
-func FormatSessionURI(serverURL string, path string, token string) string {
+// The trailing slash of serverURL (e.g. https://gateway.example/reana/) is not repeated.
+// The token is redacted unless showToken is set.
+func FormatSessionURI(serverURL string, path string, token string, showToken bool) string {
+	if strings.HasPrefix(path, "/") {
+		serverURL = strings.TrimSuffix(serverURL, "/")
+	}
+	if !showToken {
+		token = RedactToken(token)
+	}
 	return serverURL + path + "?token=" + token
 }
+
+// RedactToken hides the given access token, so that it can be displayed or logged.
+func RedactToken(token string) string {
+	if token == "" {
+		return ""
+	}
+	return RedactedToken
+}
+
+// RedactTokens hides the access tokens passed as query parameters (access_token=... or token=...) in
+// the given text, e.g. in the URLs of logged requests, as well as the ones of JSON documents, e.g.
+// in the logged response bodies, see tokenFieldPatterns.
+func RedactTokens(text string) string {
+	text = tokenParamPattern.ReplaceAllString(text, "${1}"+RedactedToken)
+	for _, pattern := range tokenFieldPatterns {
+		text = pattern.ReplaceAllString(text, "${1}"+RedactedToken+`"`)
+	}
+	return text
+}
+
+// FormatFileSize formats a size in bytes in a human readable way, using binary units (e.g. 1.89 KiB).
+// It follows the same format as the human readable sizes provided by the REANA server.
+func FormatFileSize(size int64) string {
+	units := []string{"KiB", "MiB", "GiB", "TiB", "PiB"}
+	if size < 1024 {
+		return fmt.Sprintf("%d Bytes", size)
+	}
+
+	value := float64(size) / 1024
+	unit := units[0]
+	for _, nextUnit := range units[1:] {
+		if value < 1024 {
+			break
+		}
+		value /= 1024
+		unit = nextUnit
+	}
+	return fmt.Sprintf("%.2f %s", value, unit)
+}
@@ -10,6 +10,7 @@ package formatter
 
 import (
 	"fmt"
+	"reanahub/reana-client-go/pkg/filterer"
 	"reflect"
 	"strings"
 	"testing"
@@ -91,6 +92,7 @@ func TestFormatDataFrame(t *testing.T) {
 	tests := map[string]struct {
 		df            dataframe.DataFrame
 		formatFilters []FormatFilter
+		nRows         int
 		wantError     bool
 	}{
 		"no format": {
@@ -126,6 +128,17 @@ func TestFormatDataFrame(t *testing.T) {
 				{column: "col3", filterRows: true, value: "false"},
 			},
 		},
+		"format with operators": {
+			df: dataframe.New(
+				series.New([]string{"a", "b", "c"}, series.String, "col1"),
+				series.New([]int{1, 2, 3}, series.Int, "col2"),
+			),
+			formatFilters: []FormatFilter{
+				{column: "col1", filterRows: true, operator: filterer.NotEqual, value: "c"},
+				{column: "col2", filterRows: true, operator: filterer.Greater, value: "1"},
+			},
+			nRows: 1,
+		},
 		"invalid format column": {
 			df: dataframe.New(
 				series.New([]string{"a", "b"}, series.String, "col1"),
@@ -155,13 +168,16 @@ func TestFormatDataFrame(t *testing.T) {
 					if df.Ncol() != len(test.formatFilters) {
 						t.Fatalf("Expected %d columns, got %d", len(test.formatFilters), df.Ncol())
 					}
+					if test.nRows != 0 && df.Nrow() != test.nRows {
+						t.Errorf("Expected %d rows, got %d", test.nRows, df.Nrow())
+					}
 					for _, filter := range test.formatFilters {
 						if !slices.Contains(df.Names(), filter.column) {
 							t.Errorf("Expected column '%s' to be present", filter.column)
 							continue
 						}
 
-						if filter.filterRows {
+						if filter.filterRows && filter.operator == "" {
 							col := df.Col(filter.column)
 							for i := 0; i < col.Len(); i++ {
 								if fmt.Sprintf("%v", col.Val(i)) != filter.value {
@@ -290,24 +306,108 @@ func TestFormatSessionURI(t *testing.T) {
 		serverURL string
 		path      string
 		token     string
+		showToken bool
 		want      string
 	}{
 		"regular uri": {
 			serverURL: "https://server.com",
 			path:      "/api/",
 			token:     "token",
+			showToken: true,
 			want:      "https://server.com/api/?token=token",
 		},
 		"no path": {
 			serverURL: "https://server.com/",
 			path:      "",
 			token:     "token",
+			showToken: true,
 			want:      "https://server.com/?token=token",
 		},
+		"base path": {
+			serverURL: "https://gateway.example/reana/",
+			path:      "/api/",
+			token:     "token",
+			showToken: true,
+			want:      "https://gateway.example/reana/api/?token=token",
+		},
+		"redacted token": {
+			serverURL: "https://server.com",
+			path:      "/api/",
+			token:     "token",
+			want:      "https://server.com/api/?token=REDACTED",
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			got := FormatSessionURI(test.serverURL, test.path, test.token, test.showToken)
+			if got != test.want {
+				t.Errorf("Expected %s, got %s", test.want, got)
+			}
+		})
+	}
+}
+
+func TestRedactTokens(t *testing.T) {
+	tests := map[string]struct {
+		text string
+		want string
+	}{
+		"request url": {
+			text: "GET /api/you?access_token=secret HTTP/1.1",
+			want: "GET /api/you?access_token=REDACTED HTTP/1.1",
+		},
+		"several parameters": {
+			text: `Get "https://reana.cern.ch/api/workflows?access_token=secret&page=1": EOF`,
+			want: `Get "https://reana.cern.ch/api/workflows?access_token=REDACTED&page=1": EOF`,
+		},
+		"session uri": {
+			text: "https://reana.cern.ch/abc/jupyter?token=secret",
+			want: "https://reana.cern.ch/abc/jupyter?token=REDACTED",
+		},
+		"no token": {
+			text: "csrf_token_name=value and tokens=1",
+			want: "csrf_token_name=value and tokens=1",
+		},
+		"json fields": {
+			text: `{"access_token": "secret", "token":"se\"cret", "name": "token"}`,
+			want: `{"access_token": "REDACTED", "token":"REDACTED", "name": "token"}`,
+		},
+		"reana token": {
+			text: `"reana_token": {"status": "active", "value": "secret"}`,
+			want: `"reana_token": {"status": "active", "value": "REDACTED"}`,
+		},
+		"other values": {
+			text: `{"quota": {"value": "1"}, "reana_token": {"status": "requested"}, "value": "2"}`,
+			want: `{"quota": {"value": "1"}, "reana_token": {"status": "requested"}, "value": "2"}`,
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			if got := RedactTokens(test.text); got != test.want {
+				t.Errorf("Expected %s, got %s", test.want, got)
+			}
+		})
+	}
+
+	if RedactToken("") != "" || RedactToken("secret") != RedactedToken {
+		t.Errorf("Expected empty tokens to be kept and others to be redacted")
+	}
+}
+
+func TestFormatFileSize(t *testing.T) {
+	tests := map[string]struct {
+		size int64
+		want string
+	}{
+		"bytes":     {size: 937, want: "937 Bytes"},
+		"kibibytes": {size: 1937, want: "1.89 KiB"},
+		"rounded":   {size: 154455, want: "150.83 KiB"},
+		"mebibytes": {size: 5 * 1024 * 1024, want: "5.00 MiB"},
+		"gibibytes": {size: 3 * 1024 * 1024 * 1024, want: "3.00 GiB"},
 	}
 	for name, test := range tests {
 		t.Run(name, func(t *testing.T) {
-			got := FormatSessionURI(test.serverURL, test.path, test.token)
+			got := FormatFileSize(test.size)
 			if got != test.want {
 				t.Errorf("Expected %s, got %s", test.want, got)
 			}
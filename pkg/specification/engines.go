@@ -0,0 +1,201 @@
+/*
+This file is part of REANA.
+Copyright (C) 2022 CERN.
+
+REANA is free software; you can redistribute it and/or modify it
+under the terms of the MIT License; see LICENSE file for more details.
+*/
+
+package specification
+
+import (
+	"fmt"
+	"path/filepath"
+	"reanahub/reana-client-go/pkg/validator"
+	"regexp"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// cwlClasses lists the classes of CWL processes that can be run as a workflow.
+var cwlClasses = []string{"Workflow", "CommandLineTool", "ExpressionTool"}
+
+// serialParameter matches the input parameters used in the commands of serial steps, e.g. ${events}.
+var serialParameter = regexp.MustCompile(`\$\{(\w+)\}`)
+
+// checkEngine runs the checks specific to the workflow engine on the workflow specification in node.
+// node is nil when the workflow specification could not be loaded, in which case only the checks
+// that do not depend on it are run.
+func (v *specValidator) checkEngine(workflowKey, node *yaml.Node) {
+	switch v.spec.Workflow.Type {
+	case "serial":
+		if node != nil {
+			v.checkSerial(node)
+		}
+	case "cwl":
+		if node != nil {
+			v.checkCWL(node)
+		}
+	case "yadage":
+		v.checkYadage(node)
+	case "snakemake":
+		if v.spec.Workflow.File == "" {
+			v.errorf(workflowKey.Line, "snakemake workflows must be provided with 'workflow.file'")
+		}
+	}
+}
+
+// checkSerial verifies that every step of a serial workflow has a name, an environment and
+// some commands, and warns about the input parameters that are not declared or not used.
+func (v *specValidator) checkSerial(node *yaml.Node) {
+	_, stepsNode := findKey(node, "steps")
+	if stepsNode == nil {
+		v.workflowErrorf(node.Line, "missing required property 'steps'")
+		return
+	}
+	if stepsNode.Kind != yaml.SequenceNode {
+		v.workflowErrorf(stepsNode.Line, "property 'steps' must be a list")
+		return
+	}
+
+	names := map[string]int{}
+	used := map[string]bool{}
+	for i, step := range stepsNode.Content {
+		label := fmt.Sprintf("step %d", i+1)
+		if step.Kind != yaml.MappingNode {
+			v.workflowErrorf(step.Line, "%s must be a mapping", label)
+			continue
+		}
+
+		_, nameNode := findKey(step, "name")
+		if nameNode == nil || nameNode.Value == "" {
+			v.workflowWarnf(
+				step.Line,
+				"%s has no name, so it cannot be used with FROM and TARGET operational options",
+				label,
+			)
+		} else {
+			label = fmt.Sprintf("step '%s'", nameNode.Value)
+			if line, ok := names[nameNode.Value]; ok {
+				v.workflowErrorf(nameNode.Line, "%s is already defined at line %d", label, line)
+			}
+			names[nameNode.Value] = nameNode.Line
+		}
+
+		_, envNode := findKey(step, "environment")
+		if envNode == nil || envNode.Value == "" {
+			v.workflowErrorf(step.Line, "%s is missing required property 'environment'", label)
+		}
+
+		_, commandsNode := findKey(step, "commands")
+		commands := sequenceItems(commandsNode)
+		if len(commands) == 0 {
+			v.workflowErrorf(step.Line, "%s must have at least one command in 'commands'", label)
+		}
+		for _, command := range commands {
+			for _, match := range serialParameter.FindAllStringSubmatch(command.Value, -1) {
+				param := match[1]
+				used[param] = true
+				if _, declared := v.spec.Inputs.Parameters[param]; !declared {
+					v.workflowWarnf(
+						command.Line,
+						"parameter '%s' used in %s is not declared in 'inputs.parameters'",
+						param, label,
+					)
+				}
+			}
+		}
+	}
+
+	for _, param := range mappingKeys(v.paramsNode) {
+		if !used[param.Value] {
+			v.warnf(param.Line, "parameter '%s' is not used in any step", param.Value)
+		}
+	}
+}
+
+// checkCWL verifies the version and class of a CWL document, packed or not, and warns about the
+// input parameters that are not inputs of the CWL workflow.
+func (v *specValidator) checkCWL(node *yaml.Node) {
+	process := node
+	if _, graphNode := findKey(node, "$graph"); graphNode != nil {
+		process = cwlMainProcess(graphNode)
+		if process == nil {
+			v.workflowErrorf(graphNode.Line, "packed CWL document has no main process")
+			return
+		}
+	}
+
+	if _, versionNode := findKey(node, "cwlVersion"); versionNode == nil {
+		if _, versionNode = findKey(process, "cwlVersion"); versionNode == nil {
+			v.workflowErrorf(node.Line, "missing required property 'cwlVersion'")
+		}
+	}
+
+	_, classNode := findKey(process, "class")
+	if classNode == nil {
+		v.workflowErrorf(process.Line, "missing required property 'class'")
+	} else if err := validator.ValidateChoice(classNode.Value, cwlClasses, "class"); err != nil {
+		v.workflowErrorf(classNode.Line, "%s", err.Error())
+	}
+
+	_, inputsNode := findKey(process, "inputs")
+	inputs := map[string]bool{}
+	for _, input := range mappingKeys(inputsNode) {
+		inputs[input.Value] = true
+	}
+	for _, input := range sequenceItems(inputsNode) {
+		if _, idNode := findKey(input, "id"); idNode != nil {
+			inputs[cwlShortID(idNode.Value)] = true
+		}
+	}
+	for _, param := range mappingKeys(v.paramsNode) {
+		if !inputs[param.Value] {
+			v.warnf(param.Line, "parameter '%s' is not an input of the CWL workflow", param.Value)
+		}
+	}
+}
+
+// cwlMainProcess returns the main process of a packed CWL document, which is the one with the
+// "main" identifier or, failing that, the first workflow of the graph.
+func cwlMainProcess(graphNode *yaml.Node) *yaml.Node {
+	var firstWorkflow *yaml.Node
+	for _, process := range sequenceItems(graphNode) {
+		if _, idNode := findKey(process, "id"); idNode != nil {
+			if strings.TrimPrefix(idNode.Value, "#") == "main" {
+				return process
+			}
+		}
+		_, classNode := findKey(process, "class")
+		if firstWorkflow == nil && classNode != nil && classNode.Value == "Workflow" {
+			firstWorkflow = process
+		}
+	}
+	return firstWorkflow
+}
+
+// cwlShortID returns the name of a CWL identifier, removing the process it belongs to,
+// e.g. #main/events becomes events.
+func cwlShortID(id string) string {
+	id = strings.TrimPrefix(id, "#")
+	return id[strings.LastIndex(id, "/")+1:]
+}
+
+// checkYadage verifies that the toplevel directory of a yadage workflow exists locally and that
+// its workflow specification has stages.
+func (v *specValidator) checkYadage(node *yaml.Node) {
+	toplevelKey, toplevelNode := findKey(v.optionsNode, "toplevel")
+	if toplevelNode != nil && !strings.HasPrefix(toplevelNode.Value, "github:") {
+		path := filepath.Join(filepath.Dir(v.file), toplevelNode.Value)
+		if err := validator.ValidateDirectory(path); err != nil {
+			v.errorf(toplevelKey.Line, "%s", err.Error())
+		}
+	}
+
+	if node != nil {
+		if _, stagesNode := findKey(node, "stages"); stagesNode == nil {
+			v.workflowErrorf(node.Line, "missing required property 'stages'")
+		}
+	}
+}
@@ -0,0 +1,325 @@
+/*
+This file is part of REANA.
+Copyright (C) 2022 CERN.
+
+REANA is free software; you can redistribute it and/or modify it
+under the terms of the MIT License; see LICENSE file for more details.
+*/
+
+package specification
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestValidateEngines(t *testing.T) {
+	tests := map[string]struct {
+		files      map[string]string
+		wantErrors []ValidationError
+	}{
+		"serial steps": {
+			files: map[string]string{
+				"reana.yaml": `
+inputs:
+  parameters:
+    events: 100
+    unused: 1
+workflow:
+  type: serial
+  specification:
+    steps:
+      - environment: python:3.10
+        commands: [python gendata.py]
+      - name: fit
+        commands:
+          - python fit.py --events ${events} --model ${model}
+      - name: fit
+        environment: python:3.10
+        commands: []
+`,
+			},
+			wantErrors: []ValidationError{
+				{
+					File: "reana.yaml",
+					Line: 10,
+					Message: "step 1 has no name, so it cannot be used with FROM and TARGET " +
+						"operational options",
+					Warning: true,
+				},
+				{
+					File:    "reana.yaml",
+					Line:    12,
+					Message: "step 'fit' is missing required property 'environment'",
+				},
+				{
+					File: "reana.yaml",
+					Line: 14,
+					Message: "parameter 'model' used in step 'fit' is not declared in " +
+						"'inputs.parameters'",
+					Warning: true,
+				},
+				{
+					File:    "reana.yaml",
+					Line:    15,
+					Message: "step 'fit' is already defined at line 12",
+				},
+				{
+					File:    "reana.yaml",
+					Line:    15,
+					Message: "step 'fit' must have at least one command in 'commands'",
+				},
+				{
+					File:    "reana.yaml",
+					Line:    5,
+					Message: "parameter 'unused' is not used in any step",
+					Warning: true,
+				},
+			},
+		},
+		"serial without steps": {
+			files: map[string]string{
+				"reana.yaml": "workflow:\n  type: serial\n  file: workflow.yaml\n",
+				"workflow.yaml": "version: 0.1\n" +
+					"steps: {}\n",
+			},
+			wantErrors: []ValidationError{
+				{File: "workflow.yaml", Line: 2, Message: "property 'steps' must be a list"},
+			},
+		},
+		"cwl workflow": {
+			files: map[string]string{
+				"reana.yaml": `
+inputs:
+  parameters:
+    events: 100
+    model: gauss
+workflow:
+  type: cwl
+  file: workflow.cwl
+`,
+				"workflow.cwl": `
+cwlVersion: v1.0
+class: Workflow
+inputs:
+  events: int
+outputs: []
+steps: []
+`,
+			},
+			wantErrors: []ValidationError{
+				{
+					File:    "reana.yaml",
+					Line:    5,
+					Message: "parameter 'model' is not an input of the CWL workflow",
+					Warning: true,
+				},
+			},
+		},
+		"packed cwl workflow": {
+			files: map[string]string{
+				"reana.yaml": `
+inputs:
+  parameters:
+    events: 100
+workflow:
+  type: cwl
+  file: workflow.json
+`,
+				"workflow.json": `{
+  "cwlVersion": "v1.0",
+  "$graph": [
+    {"id": "#gendata", "class": "CommandLineTool", "inputs": []},
+    {"id": "#main", "class": "Workflow", "inputs": [{"id": "#main/events"}]}
+  ]
+}`,
+			},
+		},
+		"invalid cwl document": {
+			files: map[string]string{
+				"reana.yaml": "workflow:\n  type: cwl\n  file: workflow.cwl\n",
+				"workflow.cwl": "class: Tool\n" +
+					"inputs: []\n",
+			},
+			wantErrors: []ValidationError{
+				{File: "workflow.cwl", Line: 1, Message: "missing required property 'cwlVersion'"},
+				{
+					File: "workflow.cwl",
+					Line: 1,
+					Message: "invalid value for 'class': 'Tool' is not part of " +
+						"'Workflow', 'CommandLineTool', 'ExpressionTool'",
+				},
+			},
+		},
+		"yadage workflow": {
+			files: map[string]string{
+				"reana.yaml": `
+inputs:
+  options:
+    toplevel: workflow
+workflow:
+  type: yadage
+  file: workflow.yml
+`,
+				"workflow/workflow.yml": "stages: []\n",
+			},
+		},
+		"yadage without stages": {
+			files: map[string]string{
+				"reana.yaml": `
+workflow:
+  type: yadage
+  specification:
+    steps: []
+`,
+			},
+			wantErrors: []ValidationError{
+				{File: "reana.yaml", Line: 5, Message: "missing required property 'stages'"},
+			},
+		},
+		"yadage missing toplevel": {
+			files: map[string]string{
+				"reana.yaml": `
+inputs:
+  options:
+    toplevel: missing
+workflow:
+  type: yadage
+  specification:
+    stages: []
+`,
+			},
+			wantErrors: []ValidationError{
+				{File: "reana.yaml", Line: 4, Message: "directory 'missing' does not exist"},
+			},
+		},
+		"yadage remote toplevel": {
+			files: map[string]string{
+				"reana.yaml": `
+inputs:
+  options:
+    toplevel: github:reanahub/reana-demo-bsm-search:workflow/yadage
+workflow:
+  type: yadage
+  file: workflow.yml
+`,
+			},
+		},
+		"snakemake workflow": {
+			files: map[string]string{
+				"reana.yaml": "workflow:\n  type: snakemake\n  file: Snakefile\n",
+				"Snakefile":  "rule all:\n",
+			},
+		},
+		"snakemake inline specification": {
+			files: map[string]string{
+				"reana.yaml": "workflow:\n  type: snakemake\n  specification: {}\n",
+			},
+			wantErrors: []ValidationError{
+				{
+					File:    "reana.yaml",
+					Line:    1,
+					Message: "snakemake workflows must be provided with 'workflow.file'",
+				},
+			},
+		},
+		"missing snakefile": {
+			files: map[string]string{
+				"reana.yaml": "workflow:\n  type: snakemake\n  file: Snakefile\n",
+			},
+			wantErrors: []ValidationError{
+				{
+					File: "reana.yaml",
+					Line: 3,
+					Message: "cannot read workflow file 'Snakefile': " +
+						"open Snakefile: no such file or directory",
+				},
+			},
+		},
+		"operational options": {
+			files: map[string]string{
+				"reana.yaml": `
+inputs:
+  options:
+    CACHE: "off"
+    report: report.html
+    INVALID: 1
+workflow:
+  type: serial
+  specification: {steps: []}
+`,
+			},
+			wantErrors: []ValidationError{
+				{
+					File:    "reana.yaml",
+					Line:    5,
+					Message: "operational option 'report' not supported for serial workflows",
+				},
+				{
+					File:    "reana.yaml",
+					Line:    6,
+					Message: "operational option 'INVALID' not supported",
+				},
+			},
+		},
+		"missing inputs": {
+			files: map[string]string{
+				"reana.yaml": `
+inputs:
+  files: [code/main.py, data]
+  directories: [data, code/main.py, missing]
+workflow:
+  type: serial
+  specification: {steps: []}
+`,
+				"code/main.py":  "print('hello')\n",
+				"data/data.txt": "",
+			},
+			wantErrors: []ValidationError{
+				{File: "reana.yaml", Line: 3, Message: "file 'data' is a directory", Warning: true},
+				{
+					File:    "reana.yaml",
+					Line:    4,
+					Message: "'code/main.py' is not a directory",
+					Warning: true,
+				},
+				{
+					File:    "reana.yaml",
+					Line:    4,
+					Message: "directory 'missing' does not exist",
+					Warning: true,
+				},
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			dir := t.TempDir()
+			for file, content := range test.files {
+				path := filepath.Join(dir, filepath.FromSlash(file))
+				if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+					t.Fatal(err)
+				}
+				if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			_, errs := Validate(filepath.Join(dir, "reana.yaml"))
+			if len(errs) != len(test.wantErrors) {
+				t.Fatalf("Expected errors %v, instead got %v", test.wantErrors, errs)
+			}
+			for i, err := range errs {
+				// Make the file paths relative to the temporary directory
+				err.File, _ = filepath.Rel(dir, err.File)
+				err.Message = strings.ReplaceAll(err.Message, dir+string(filepath.Separator), "")
+				if err != test.wantErrors[i] {
+					t.Errorf("Expected error %#v, instead got %#v", test.wantErrors[i], err)
+				}
+			}
+		})
+	}
+}
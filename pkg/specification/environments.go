@@ -0,0 +1,242 @@
+/*
+This file is part of REANA.
+Copyright (C) 2022 CERN.
+
+REANA is free software; you can redistribute it and/or modify it
+under the terms of the MIT License; see LICENSE file for more details.
+*/
+
+package specification
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"golang.org/x/exp/slices"
+	"gopkg.in/yaml.v3"
+)
+
+// defaultRegistry registry of the container images whose name does not specify one.
+const defaultRegistry = "docker.io"
+
+// Environment represents a container image used by a step of the workflow.
+// File and Line locate where the image is declared.
+type Environment struct {
+	Image    string
+	Step     string
+	File     string
+	Line     int
+	Problems []string
+}
+
+// Location returns where the image is declared, in the format file:line.
+func (e Environment) Location() string {
+	return fmt.Sprintf("%s:%d", e.File, e.Line)
+}
+
+// CheckEnvironments finds the container images declared by the serial steps, yadage stages and CWL
+// DockerRequirement hints of the workflow in the reana.yaml file in the given path.
+// Every image without a tag, or with the latest tag, is flagged, as well as the images from registries
+// not part of allowedRegistries, when given.
+func CheckEnvironments(path string, allowedRegistries []string) ([]Environment, error) {
+	spec, workflowFile, node, err := loadWorkflowNode(path)
+	if err != nil || node == nil {
+		return nil, err
+	}
+
+	var environments []Environment
+	switch spec.Workflow.Type {
+	case "serial":
+		environments = serialEnvironments(node)
+	case "yadage":
+		environments = yadageEnvironments(node, "")
+	case "cwl":
+		environments = cwlEnvironments(node, "")
+	}
+
+	for i := range environments {
+		environments[i].File = workflowFile
+		environments[i].Problems = imageProblems(environments[i].Image, allowedRegistries)
+	}
+	return environments, nil
+}
+
+// loadWorkflowNode loads the reana.yaml file in the given path and the YAML node of the workflow
+// specification, either inline or from the workflow file.
+// Returns the specification, the file holding the workflow specification and its node, which is nil
+// when the workflow specification cannot be parsed, as for Snakemake and remote yadage workflows.
+func loadWorkflowNode(path string) (*Specification, string, *yaml.Node, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, "", nil, fmt.Errorf("cannot read specification file '%s': %v", path, err)
+	}
+
+	var root yaml.Node
+	if err := yaml.Unmarshal(content, &root); err != nil {
+		return nil, "", nil, fmt.Errorf("cannot parse specification file %w", yamlErrors(path, err))
+	}
+	var spec Specification
+	if err := root.Decode(&spec); err != nil {
+		return nil, "", nil, fmt.Errorf("cannot parse specification file %w", yamlErrors(path, err))
+	}
+
+	if len(root.Content) > 0 {
+		_, workflowNode := findKey(root.Content[0], "workflow")
+		if _, specNode := findKey(workflowNode, "specification"); specNode != nil {
+			return &spec, path, specNode, nil
+		}
+	}
+	if spec.Workflow.File == "" {
+		return &spec, path, nil, nil
+	}
+	workflowFile, local := workflowFilePath(path, &spec)
+	if !local {
+		return &spec, path, nil, nil
+	}
+	node, err := readWorkflowFile(spec.Workflow.Type, workflowFile)
+	return &spec, workflowFile, node, err
+}
+
+// serialEnvironments returns the environments of the steps of a serial workflow.
+func serialEnvironments(node *yaml.Node) []Environment {
+	var environments []Environment
+	_, stepsNode := findKey(node, "steps")
+	for i, step := range sequenceItems(stepsNode) {
+		name := fmt.Sprintf("%d", i+1)
+		if _, nameNode := findKey(step, "name"); nameNode != nil && nameNode.Value != "" {
+			name = nameNode.Value
+		}
+		for _, key := range []string{"environment", "docker_img"} {
+			if _, envNode := findKey(step, key); envNode != nil {
+				environments = append(environments, Environment{
+					Image: envNode.Value,
+					Step:  name,
+					Line:  envNode.Line,
+				})
+			}
+		}
+	}
+	return environments
+}
+
+// yadageEnvironments returns the environments of the stages of a yadage workflow, including the ones
+// of its subworkflows. The names of the stages are prefixed by the names of their parents.
+// Stages referencing other files, through $ref, are not followed.
+func yadageEnvironments(node *yaml.Node, prefix string) []Environment {
+	var environments []Environment
+	_, stagesNode := findKey(node, "stages")
+	for _, stage := range sequenceItems(stagesNode) {
+		name := prefix
+		if _, nameNode := findKey(stage, "name"); nameNode != nil {
+			name = prefix + nameNode.Value
+		}
+		_, schedulerNode := findKey(stage, "scheduler")
+
+		_, stepNode := findKey(schedulerNode, "step")
+		_, envNode := findKey(stepNode, "environment")
+		if _, imageNode := findKey(envNode, "image"); imageNode != nil {
+			image := imageNode.Value
+			if _, tagNode := findKey(envNode, "imagetag"); tagNode != nil {
+				image += ":" + tagNode.Value
+			}
+			environments = append(environments, Environment{
+				Image: image,
+				Step:  name,
+				Line:  imageNode.Line,
+			})
+		}
+
+		_, workflowNode := findKey(schedulerNode, "workflow")
+		environments = append(environments, yadageEnvironments(workflowNode, name+"/")...)
+	}
+	return environments
+}
+
+// cwlEnvironments returns the images of the DockerRequirement found anywhere in a CWL document, either
+// in the list form ({class: DockerRequirement, dockerPull: image}) or in the map form
+// (DockerRequirement: {dockerPull: image}). Each image is associated to the closest process identifier.
+func cwlEnvironments(node *yaml.Node, id string) []Environment {
+	var environments []Environment
+	switch node.Kind {
+	case yaml.MappingNode:
+		if _, idNode := findKey(node, "id"); idNode != nil {
+			id = cwlShortID(idNode.Value)
+		}
+		_, classNode := findKey(node, "class")
+		_, pullNode := findKey(node, "dockerPull")
+		if classNode != nil && classNode.Value == "DockerRequirement" && pullNode != nil {
+			environments = append(environments, Environment{
+				Image: pullNode.Value,
+				Step:  id,
+				Line:  pullNode.Line,
+			})
+		}
+		for i := 0; i < len(node.Content); i += 2 {
+			key, value := node.Content[i], node.Content[i+1]
+			if key.Value == "DockerRequirement" {
+				if _, pullNode := findKey(value, "dockerPull"); pullNode != nil {
+					environments = append(environments, Environment{
+						Image: pullNode.Value,
+						Step:  id,
+						Line:  pullNode.Line,
+					})
+				}
+				continue
+			}
+			environments = append(environments, cwlEnvironments(value, id)...)
+		}
+	case yaml.SequenceNode:
+		for _, item := range node.Content {
+			environments = append(environments, cwlEnvironments(item, id)...)
+		}
+	}
+	return environments
+}
+
+// parseImage splits a container image reference into its registry, repository and tag.
+// The registry defaults to defaultRegistry and the tag is empty when not given.
+// pinned is true if the image has a digest or a tag other than latest.
+func parseImage(image string) (registry, repository, tag string, pinned bool) {
+	name := image
+	digest := ""
+	if i := strings.Index(name, "@"); i >= 0 {
+		name, digest = name[:i], name[i+1:]
+	}
+
+	registry = defaultRegistry
+	if i := strings.Index(name, "/"); i >= 0 {
+		first := name[:i]
+		if strings.ContainsAny(first, ".:") || first == "localhost" {
+			registry, name = first, name[i+1:]
+		}
+	}
+
+	repository = name
+	if i := strings.LastIndex(name, ":"); i >= 0 {
+		repository, tag = name[:i], name[i+1:]
+	}
+
+	pinned = digest != "" || (tag != "" && tag != "latest")
+	return registry, repository, tag, pinned
+}
+
+// imageProblems returns the reasons why the given image is flagged, if any.
+func imageProblems(image string, allowedRegistries []string) []string {
+	var problems []string
+	registry, _, tag, pinned := parseImage(image)
+	if !pinned {
+		if tag == "" {
+			problems = append(problems, "image has no tag, latest will be used")
+		} else {
+			problems = append(problems, "image uses the latest tag")
+		}
+	}
+	if len(allowedRegistries) > 0 && !slices.Contains(allowedRegistries, registry) {
+		problems = append(
+			problems,
+			fmt.Sprintf("registry '%s' is not part of the allowed registries", registry),
+		)
+	}
+	return problems
+}
@@ -0,0 +1,234 @@
+/*
+This file is part of REANA.
+Copyright (C) 2022 CERN.
+
+REANA is free software; you can redistribute it and/or modify it
+under the terms of the MIT License; see LICENSE file for more details.
+*/
+
+package specification
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestCheckEnvironments(t *testing.T) {
+	tests := map[string]struct {
+		files             map[string]string
+		allowedRegistries []string
+		expected          []Environment
+	}{
+		"serial steps": {
+			files: map[string]string{
+				"reana.yaml": `
+workflow:
+  type: serial
+  specification:
+    steps:
+      - name: gendata
+        environment: docker.io/library/python:3.10-bookworm
+        commands: [python gendata.py]
+      - environment: python
+        commands: [python fit.py]
+      - name: plot
+        environment: reanahub/reana-env-root6:latest
+        commands: [root plot.C]
+`,
+			},
+			expected: []Environment{
+				{
+					Image: "docker.io/library/python:3.10-bookworm",
+					Step:  "gendata",
+					File:  "reana.yaml",
+					Line:  7,
+				},
+				{
+					Image:    "python",
+					Step:     "2",
+					File:     "reana.yaml",
+					Line:     9,
+					Problems: []string{"image has no tag, latest will be used"},
+				},
+				{
+					Image:    "reanahub/reana-env-root6:latest",
+					Step:     "plot",
+					File:     "reana.yaml",
+					Line:     12,
+					Problems: []string{"image uses the latest tag"},
+				},
+			},
+		},
+		"yadage stages": {
+			files: map[string]string{
+				"reana.yaml": "workflow:\n  type: yadage\n  file: workflow.yaml\n",
+				"workflow.yaml": `
+stages:
+  - name: gendata
+    scheduler:
+      step:
+        environment:
+          image: reanahub/reana-env-root6
+          imagetag: '6.18.04'
+  - name: fit
+    scheduler:
+      workflow:
+        stages:
+          - name: fitdata
+            scheduler:
+              step:
+                environment:
+                  image: reanahub/reana-env-root6
+`,
+			},
+			expected: []Environment{
+				{
+					Image: "reanahub/reana-env-root6:6.18.04",
+					Step:  "gendata",
+					File:  "workflow.yaml",
+					Line:  7,
+				},
+				{
+					Image:    "reanahub/reana-env-root6",
+					Step:     "fit/fitdata",
+					File:     "workflow.yaml",
+					Line:     17,
+					Problems: []string{"image has no tag, latest will be used"},
+				},
+			},
+		},
+		"cwl docker requirements": {
+			files: map[string]string{
+				"reana.yaml": "workflow:\n  type: cwl\n  file: workflow.cwl\n",
+				"workflow.cwl": `
+cwlVersion: v1.0
+$graph:
+  - id: gendata
+    class: CommandLineTool
+    requirements:
+      - class: DockerRequirement
+        dockerPull: gitlab-registry.cern.ch/reana/gendata:1.0
+  - id: main
+    class: Workflow
+    hints:
+      DockerRequirement:
+        dockerPull: python@sha256:1234
+`,
+			},
+			allowedRegistries: []string{"gitlab-registry.cern.ch"},
+			expected: []Environment{
+				{
+					Image: "gitlab-registry.cern.ch/reana/gendata:1.0",
+					Step:  "gendata",
+					File:  "workflow.cwl",
+					Line:  8,
+				},
+				{
+					Image: "python@sha256:1234",
+					Step:  "main",
+					File:  "workflow.cwl",
+					Line:  13,
+					Problems: []string{
+						"registry 'docker.io' is not part of the allowed registries",
+					},
+				},
+			},
+		},
+		"snakemake": {
+			files: map[string]string{
+				"reana.yaml": "workflow:\n  type: snakemake\n  file: Snakefile\n",
+				"Snakefile":  "rule all:\n",
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			dir := t.TempDir()
+			for file, content := range test.files {
+				path := filepath.Join(dir, file)
+				if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			got, err := CheckEnvironments(
+				filepath.Join(dir, "reana.yaml"),
+				test.allowedRegistries,
+			)
+			if err != nil {
+				t.Fatalf("Got unexpected error '%s'", err.Error())
+			}
+			if len(got) != len(test.expected) {
+				t.Fatalf("Expected environments %v, instead got %v", test.expected, got)
+			}
+			for i, env := range got {
+				env.File, _ = filepath.Rel(dir, env.File)
+				want := test.expected[i]
+				if env.Image != want.Image || env.Step != want.Step ||
+					env.Location() != want.Location() ||
+					strings.Join(env.Problems, ";") != strings.Join(want.Problems, ";") {
+					t.Errorf("Expected environment %#v, instead got %#v", want, env)
+				}
+			}
+		})
+	}
+}
+
+func TestParseImage(t *testing.T) {
+	tests := map[string]struct {
+		image      string
+		registry   string
+		repository string
+		tag        string
+		pinned     bool
+	}{
+		"official image": {
+			image:      "python",
+			registry:   "docker.io",
+			repository: "python",
+		},
+		"tagged image": {
+			image:      "reanahub/reana-env-root6:6.18.04",
+			registry:   "docker.io",
+			repository: "reanahub/reana-env-root6",
+			tag:        "6.18.04",
+			pinned:     true,
+		},
+		"latest tag": {
+			image:      "python:latest",
+			registry:   "docker.io",
+			repository: "python",
+			tag:        "latest",
+		},
+		"registry with port": {
+			image:      "localhost:5000/analysis:1.0",
+			registry:   "localhost:5000",
+			repository: "analysis",
+			tag:        "1.0",
+			pinned:     true,
+		},
+		"digest": {
+			image:      "gitlab-registry.cern.ch/reana/analysis@sha256:1234",
+			registry:   "gitlab-registry.cern.ch",
+			repository: "reana/analysis",
+			pinned:     true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			registry, repository, tag, pinned := parseImage(test.image)
+			if registry != test.registry || repository != test.repository ||
+				tag != test.tag || pinned != test.pinned {
+				t.Errorf(
+					"Expected (%s, %s, %s, %t), instead got (%s, %s, %s, %t)",
+					test.registry, test.repository, test.tag, test.pinned,
+					registry, repository, tag, pinned,
+				)
+			}
+		})
+	}
+}
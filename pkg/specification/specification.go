@@ -0,0 +1,155 @@
+/*
+This file is part of REANA.
+Copyright (C) 2022 CERN.
+
+REANA is free software; you can redistribute it and/or modify it
+under the terms of the MIT License; see LICENSE file for more details.
+*/
+
+// Package specification gives data structures and functions to load REANA specification files (reana.yaml).
+package specification
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Specification represents the content of a reana.yaml file.
+type Specification struct {
+	Version   string         `yaml:"version,omitempty" json:"version,omitempty"`
+	Inputs    Inputs         `yaml:"inputs,omitempty" json:"inputs,omitempty"`
+	Workflow  Workflow       `yaml:"workflow" json:"workflow"`
+	Outputs   Outputs        `yaml:"outputs,omitempty" json:"outputs,omitempty"`
+	Workspace map[string]any `yaml:"workspace,omitempty" json:"workspace,omitempty"`
+}
+
+// Inputs represents the inputs section of a reana.yaml file.
+type Inputs struct {
+	Files       []string       `yaml:"files,omitempty" json:"files,omitempty"`
+	Directories []string       `yaml:"directories,omitempty" json:"directories,omitempty"`
+	Parameters  map[string]any `yaml:"parameters,omitempty" json:"parameters,omitempty"`
+	Options     map[string]any `yaml:"options,omitempty" json:"options,omitempty"`
+}
+
+// Workflow represents the workflow section of a reana.yaml file.
+// Specification holds either the inline specification or the content loaded from File.
+type Workflow struct {
+	Type          string         `yaml:"type" json:"type"`
+	File          string         `yaml:"file,omitempty" json:"file,omitempty"`
+	Specification any            `yaml:"specification,omitempty" json:"specification,omitempty"`
+	Resources     map[string]any `yaml:"resources,omitempty" json:"resources,omitempty"`
+}
+
+// Outputs represents the outputs section of a reana.yaml file.
+type Outputs struct {
+	Files       []string `yaml:"files,omitempty" json:"files,omitempty"`
+	Directories []string `yaml:"directories,omitempty" json:"directories,omitempty"`
+}
+
+// Load reads the reana.yaml file in the given path and loads the workflow specification it references.
+// The workflow file path is resolved relatively to the directory of the reana.yaml file.
+func Load(path string) (*Specification, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("cannot read specification file '%s': %v", path, err)
+	}
+
+	var spec Specification
+	if err := yaml.Unmarshal(content, &spec); err != nil {
+		return nil, fmt.Errorf("cannot parse specification file %w", yamlErrors(path, err))
+	}
+
+	if spec.Workflow.Specification == nil && spec.Workflow.File != "" {
+		workflowFile, local := workflowFilePath(path, &spec)
+		if local {
+			spec.Workflow.Specification, err = loadWorkflowFile(spec.Workflow.Type, workflowFile)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return &spec, nil
+}
+
+// CheckWorkflowSpecification checks that the workflow specification is loaded, so that the workflow
+// can be created on the server. Snakefiles can only be parsed by Snakemake itself, so snakemake
+// workflows are not supported, as well as yadage workflows whose files are stored remotely.
+func (s *Specification) CheckWorkflowSpecification() error {
+	if s.Workflow.Specification != nil {
+		return nil
+	}
+	switch {
+	case s.Workflow.Type == "snakemake":
+		return errors.New(
+			"snakemake workflows are not supported, as the Snakefile cannot be parsed " +
+				"without Snakemake, please use the Python reana-client to create them",
+		)
+	case s.Workflow.File != "":
+		return fmt.Errorf(
+			"workflow file '%s' cannot be loaded, %s workflow files must be stored locally",
+			s.Workflow.File, s.Workflow.Type,
+		)
+	default:
+		return errors.New("the workflow specification is empty")
+	}
+}
+
+// workflowFilePath resolves the path of the workflow file relatively to the reana.yaml file in specPath.
+// Yadage workflow files are resolved relatively to the toplevel operational option, if set.
+// Returns false if the workflow file is not stored locally, as for yadage toplevel GitHub repositories.
+func workflowFilePath(specPath string, spec *Specification) (string, bool) {
+	baseDir := filepath.Dir(specPath)
+	if spec.Workflow.Type == "yadage" {
+		if toplevel, ok := spec.Inputs.Options["toplevel"].(string); ok {
+			if strings.HasPrefix(toplevel, "github:") {
+				return "", false
+			}
+			baseDir = filepath.Join(baseDir, toplevel)
+		}
+	}
+	return filepath.Join(baseDir, spec.Workflow.File), true
+}
+
+// loadWorkflowFile loads the workflow specification file of the given workflow type.
+// Parsing errors are returned as ValidationErrors, pointing to the lines of the workflow file.
+func loadWorkflowFile(workflowType, path string) (any, error) {
+	node, err := readWorkflowFile(workflowType, path)
+	if err != nil || node == nil {
+		return nil, err
+	}
+
+	var workflowSpec any
+	if err := node.Decode(&workflowSpec); err != nil {
+		return nil, fmt.Errorf("cannot parse workflow file %w", yamlErrors(path, err))
+	}
+	return workflowSpec, nil
+}
+
+// readWorkflowFile parses the workflow specification file of the given workflow type into a YAML node.
+// Returns a nil node for empty files and for Snakefiles, which are Python code that only Snakemake
+// itself can interpret, so they are only checked for presence.
+func readWorkflowFile(workflowType, path string) (*yaml.Node, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("cannot read workflow file '%s': %v", path, err)
+	}
+	if workflowType == "snakemake" {
+		return nil, nil
+	}
+
+	// JSON is a subset of YAML, so this also covers packed CWL files
+	var root yaml.Node
+	if err := yaml.Unmarshal(content, &root); err != nil {
+		return nil, fmt.Errorf("cannot parse workflow file %w", yamlErrors(path, err))
+	}
+	if len(root.Content) == 0 {
+		return nil, nil
+	}
+	return root.Content[0], nil
+}
@@ -0,0 +1,194 @@
+/*
+This file is part of REANA.
+Copyright (C) 2022 CERN.
+
+REANA is free software; you can redistribute it and/or modify it
+under the terms of the MIT License; see LICENSE file for more details.
+*/
+
+package specification
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestLoad(t *testing.T) {
+	tests := map[string]struct {
+		reanaYaml     string
+		workflowFile  string
+		wantType      string
+		wantInputs    []string
+		wantOutputs   []string
+		wantLoadedKey string
+		wantError     string
+	}{
+		"inline specification": {
+			reanaYaml: `
+inputs:
+  files: [code/main.py]
+workflow:
+  type: serial
+  specification:
+    steps: []
+outputs:
+  files: [results/plot.png]
+`,
+			wantType:      "serial",
+			wantInputs:    []string{"code/main.py"},
+			wantOutputs:   []string{"results/plot.png"},
+			wantLoadedKey: "steps",
+		},
+		"workflow file": {
+			reanaYaml: `
+workflow:
+  type: cwl
+  file: workflow.cwl
+`,
+			workflowFile:  `{"cwlVersion": "v1.0", "$graph": []}`,
+			wantType:      "cwl",
+			wantLoadedKey: "cwlVersion",
+		},
+		"missing workflow file": {
+			reanaYaml: `
+workflow:
+  type: yadage
+  file: workflow.yaml
+`,
+			wantError: "cannot read workflow file",
+		},
+		"snakemake workflow file": {
+			reanaYaml: `
+workflow:
+  type: snakemake
+  file: Snakefile
+`,
+			workflowFile: "rule all:",
+			wantType:     "snakemake",
+		},
+		"invalid yaml": {
+			reanaYaml: "workflow: [",
+			wantError: "cannot parse specification file",
+		},
+		"invalid workflow file": {
+			reanaYaml: `
+workflow:
+  type: cwl
+  file: workflow.cwl
+`,
+			workflowFile: "class: [Workflow",
+			wantError:    "cannot parse workflow file",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			dir := t.TempDir()
+			path := filepath.Join(dir, "reana.yaml")
+			if err := os.WriteFile(path, []byte(test.reanaYaml), 0644); err != nil {
+				t.Fatal(err)
+			}
+			if test.workflowFile != "" {
+				for _, file := range []string{"workflow.cwl", "Snakefile"} {
+					err := os.WriteFile(filepath.Join(dir, file), []byte(test.workflowFile), 0644)
+					if err != nil {
+						t.Fatal(err)
+					}
+				}
+			}
+
+			spec, err := Load(path)
+			if test.wantError != "" {
+				if err == nil || !strings.Contains(err.Error(), test.wantError) {
+					t.Fatalf("Expected error '%s', instead got '%v'", test.wantError, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Got unexpected error '%s'", err.Error())
+			}
+
+			if spec.Workflow.Type != test.wantType {
+				t.Errorf(
+					"Expected type '%s', instead got '%s'",
+					test.wantType, spec.Workflow.Type,
+				)
+			}
+			if strings.Join(spec.Inputs.Files, ",") != strings.Join(test.wantInputs, ",") {
+				t.Errorf(
+					"Expected input files %v, instead got %v",
+					test.wantInputs, spec.Inputs.Files,
+				)
+			}
+			if strings.Join(spec.Outputs.Files, ",") != strings.Join(test.wantOutputs, ",") {
+				t.Errorf(
+					"Expected output files %v, instead got %v",
+					test.wantOutputs, spec.Outputs.Files,
+				)
+			}
+			if test.wantLoadedKey == "" {
+				if spec.Workflow.Specification != nil {
+					t.Errorf(
+						"Expected no loaded specification, instead got %v",
+						spec.Workflow.Specification,
+					)
+				}
+				return
+			}
+			loaded, ok := spec.Workflow.Specification.(map[string]any)
+			if !ok {
+				t.Fatalf(
+					"Expected loaded specification, instead got %v",
+					spec.Workflow.Specification,
+				)
+			}
+			if _, ok := loaded[test.wantLoadedKey]; !ok {
+				t.Errorf(
+					"Expected key '%s' in specification, instead got %v",
+					test.wantLoadedKey, loaded,
+				)
+			}
+		})
+	}
+}
+
+func TestCheckWorkflowSpecification(t *testing.T) {
+	tests := map[string]struct {
+		workflow  Workflow
+		wantError string
+	}{
+		"loaded specification": {
+			workflow: Workflow{Type: "serial", Specification: map[string]any{"steps": []any{}}},
+		},
+		"snakemake workflow": {
+			workflow:  Workflow{Type: "snakemake", File: "Snakefile"},
+			wantError: "snakemake workflows are not supported",
+		},
+		"remote yadage workflow": {
+			workflow:  Workflow{Type: "yadage", File: "workflow.yaml"},
+			wantError: "workflow file 'workflow.yaml' cannot be loaded",
+		},
+		"empty specification": {
+			workflow:  Workflow{Type: "serial"},
+			wantError: "the workflow specification is empty",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			spec := Specification{Workflow: test.workflow}
+			err := spec.CheckWorkflowSpecification()
+			if test.wantError == "" {
+				if err != nil {
+					t.Errorf("Got unexpected error '%s'", err.Error())
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), test.wantError) {
+				t.Errorf("Expected error '%s', instead got '%v'", test.wantError, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,340 @@
+/*
+This file is part of REANA.
+Copyright (C) 2022 CERN.
+
+REANA is free software; you can redistribute it and/or modify it
+under the terms of the MIT License; see LICENSE file for more details.
+*/
+
+package specification
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"reanahub/reana-client-go/pkg/config"
+	"reanahub/reana-client-go/pkg/validator"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"golang.org/x/exp/slices"
+	"gopkg.in/yaml.v3"
+)
+
+// ValidationError represents a problem found in a specification file.
+// Line is the line of File where the problem was found, or 0 when it is not known.
+// Warning is set for problems that do not prevent the workflow from being run.
+type ValidationError struct {
+	File    string
+	Line    int
+	Message string
+	Warning bool
+}
+
+// Error returns the problem prefixed by its location, in the format file:line: message.
+func (e ValidationError) Error() string {
+	if e.Line > 0 {
+		return fmt.Sprintf("%s:%d: %s", e.File, e.Line, e.Message)
+	}
+	return fmt.Sprintf("%s: %s", e.File, e.Message)
+}
+
+// ValidationErrors represents all the problems found in a specification file.
+type ValidationErrors []ValidationError
+
+// Error returns the problems found, one per line.
+func (e ValidationErrors) Error() string {
+	messages := make([]string, len(e))
+	for i, err := range e {
+		messages[i] = err.Error()
+	}
+	return strings.Join(messages, "\n")
+}
+
+// HasErrors returns true if any of the problems found is not a warning.
+func (e ValidationErrors) HasErrors() bool {
+	for _, err := range e {
+		if !err.Warning {
+			return true
+		}
+	}
+	return false
+}
+
+// sections lists the sections of a reana.yaml file whose properties are checked, the root being "".
+var sections = []string{"", "inputs", "workflow", "outputs"}
+
+// allowedKeys lists the properties supported in each section of a reana.yaml file.
+var allowedKeys = map[string][]string{
+	"":         {"version", "inputs", "workflow", "outputs", "workspace"},
+	"inputs":   {"files", "directories", "parameters", "options"},
+	"workflow": {"type", "file", "specification", "resources"},
+	"outputs":  {"files", "directories"},
+}
+
+// yamlErrorLine matches the messages of the errors returned by the yaml package, e.g.
+// "yaml: line 3: did not find expected key" or "line 3: cannot unmarshal !!str into []string".
+var yamlErrorLine = regexp.MustCompile(`^(?:yaml: )?line (\d+): (.*)$`)
+
+// Validate loads the reana.yaml file in the given path, together with the workflow file it references,
+// and checks its structure as well as the workflow specification of the given engine.
+// Returns the loaded specification, or nil if the file could not be parsed, and the problems found.
+func Validate(path string) (*Specification, ValidationErrors) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, ValidationErrors{{File: path, Message: err.Error()}}
+	}
+
+	var root yaml.Node
+	if err := yaml.Unmarshal(content, &root); err != nil {
+		return nil, yamlErrors(path, err)
+	}
+	if len(root.Content) == 0 {
+		return nil, ValidationErrors{{File: path, Message: "specification file is empty"}}
+	}
+	doc := root.Content[0]
+
+	var spec Specification
+	if err := doc.Decode(&spec); err != nil {
+		return nil, yamlErrors(path, err)
+	}
+
+	v := specValidator{file: path, workflowFile: path, spec: &spec}
+	for _, section := range sections {
+		node := doc
+		if section != "" {
+			_, node = findKey(doc, section)
+		}
+		v.checkKeys(node, section, allowedKeys[section])
+	}
+
+	workflowKey, workflowNode := findKey(doc, "workflow")
+	if workflowNode == nil {
+		v.errorf(doc.Line, "missing required property 'workflow'")
+		return &spec, v.errors
+	}
+	_, inputsNode := findKey(doc, "inputs")
+	_, v.paramsNode = findKey(inputsNode, "parameters")
+	_, v.optionsNode = findKey(inputsNode, "options")
+
+	validType := v.checkWorkflowType(workflowKey, workflowNode)
+	v.checkInputs(inputsNode, validType)
+	workflowSpecNode := v.checkWorkflow(workflowKey, workflowNode)
+	if validType {
+		v.checkEngine(workflowKey, workflowSpecNode)
+	}
+
+	return &spec, v.errors
+}
+
+// specValidator accumulates the problems found while validating the specification file.
+// workflowFile is the file holding the workflow specification, which is the specification file itself
+// when the workflow specification is given inline.
+type specValidator struct {
+	file         string
+	workflowFile string
+	spec         *Specification
+	paramsNode   *yaml.Node
+	optionsNode  *yaml.Node
+	errors       ValidationErrors
+}
+
+// add records a problem found in the given line of a file.
+func (v *specValidator) add(file string, line int, warning bool, format string, a ...any) {
+	v.errors = append(v.errors, ValidationError{
+		File:    file,
+		Line:    line,
+		Message: fmt.Sprintf(format, a...),
+		Warning: warning,
+	})
+}
+
+// errorf records an error found in the given line of the specification file.
+func (v *specValidator) errorf(line int, format string, a ...any) {
+	v.add(v.file, line, false, format, a...)
+}
+
+// warnf records a warning found in the given line of the specification file.
+func (v *specValidator) warnf(line int, format string, a ...any) {
+	v.add(v.file, line, true, format, a...)
+}
+
+// workflowErrorf records an error found in the given line of the workflow specification.
+func (v *specValidator) workflowErrorf(line int, format string, a ...any) {
+	v.add(v.workflowFile, line, false, format, a...)
+}
+
+// workflowWarnf records a warning found in the given line of the workflow specification.
+func (v *specValidator) workflowWarnf(line int, format string, a ...any) {
+	v.add(v.workflowFile, line, true, format, a...)
+}
+
+// checkKeys verifies that the given mapping node only contains the allowed keys.
+func (v *specValidator) checkKeys(node *yaml.Node, section string, allowed []string) {
+	if node == nil || node.Kind != yaml.MappingNode {
+		return
+	}
+	for i := 0; i < len(node.Content); i += 2 {
+		key := node.Content[i]
+		if !slices.Contains(allowed, key.Value) {
+			v.errorf(key.Line, "unknown property '%s'", joinKeys(section, key.Value))
+		}
+	}
+}
+
+// checkWorkflowType verifies that the workflow type is given and supported.
+// Returns true if the workflow type is valid.
+func (v *specValidator) checkWorkflowType(workflowKey, workflowNode *yaml.Node) bool {
+	typeKey, _ := findKey(workflowNode, "type")
+	if typeKey == nil {
+		v.errorf(workflowKey.Line, "missing required property 'workflow.type'")
+		return false
+	}
+	err := validator.ValidateChoice(v.spec.Workflow.Type, config.WorkflowTypes, "workflow.type")
+	if err != nil {
+		v.errorf(typeKey.Line, "%s", err.Error())
+		return false
+	}
+	return true
+}
+
+// checkInputs warns about input files and directories missing locally and verifies that the
+// operational options are supported by the workflow engine, when its type is valid.
+func (v *specValidator) checkInputs(inputsNode *yaml.Node, validType bool) {
+	baseDir := filepath.Dir(v.file)
+	_, filesNode := findKey(inputsNode, "files")
+	for _, file := range sequenceItems(filesNode) {
+		if err := validator.ValidateFile(filepath.Join(baseDir, file.Value)); err != nil {
+			v.warnf(file.Line, "%s", err.Error())
+		}
+	}
+	_, directoriesNode := findKey(inputsNode, "directories")
+	for _, dir := range sequenceItems(directoriesNode) {
+		if err := validator.ValidateDirectory(filepath.Join(baseDir, dir.Value)); err != nil {
+			v.warnf(dir.Line, "%s", err.Error())
+		}
+	}
+
+	if !validType {
+		return
+	}
+	for _, option := range mappingKeys(v.optionsNode) {
+		_, err := validator.ValidateOperationalOptions(
+			v.spec.Workflow.Type,
+			map[string]string{option.Value: ""},
+		)
+		if err != nil {
+			v.errorf(option.Line, "%s", err.Error())
+		}
+	}
+}
+
+// checkWorkflow verifies how the workflow specification is provided and loads the workflow file
+// it references, if any.
+// Returns the node holding the workflow specification, or nil if it is not available.
+func (v *specValidator) checkWorkflow(workflowKey, workflowNode *yaml.Node) *yaml.Node {
+	fileKey, _ := findKey(workflowNode, "file")
+	specKey, specNode := findKey(workflowNode, "specification")
+	switch {
+	case fileKey != nil && specKey != nil:
+		v.errorf(
+			specKey.Line,
+			"'workflow.file' and 'workflow.specification' cannot be provided together",
+		)
+	case fileKey == nil && specKey == nil:
+		v.errorf(
+			workflowKey.Line,
+			"either 'workflow.file' or 'workflow.specification' must be provided",
+		)
+	case specKey != nil:
+		return specNode
+	default:
+		workflowFile, local := workflowFilePath(v.file, v.spec)
+		if !local {
+			return nil
+		}
+		node, err := readWorkflowFile(v.spec.Workflow.Type, workflowFile)
+		var fileErrors ValidationErrors
+		if errors.As(err, &fileErrors) {
+			v.errors = append(v.errors, fileErrors...)
+			return nil
+		} else if err != nil {
+			v.errorf(fileKey.Line, "%s", err.Error())
+			return nil
+		}
+		if node != nil {
+			if err := node.Decode(&v.spec.Workflow.Specification); err != nil {
+				v.errors = append(v.errors, yamlErrors(workflowFile, err)...)
+				return nil
+			}
+		}
+		v.workflowFile = workflowFile
+		return node
+	}
+	return nil
+}
+
+// findKey returns the key and value nodes of the given key in a mapping node, or nil if not found.
+func findKey(node *yaml.Node, key string) (*yaml.Node, *yaml.Node) {
+	if node == nil || node.Kind != yaml.MappingNode {
+		return nil, nil
+	}
+	for i := 0; i < len(node.Content); i += 2 {
+		if node.Content[i].Value == key {
+			return node.Content[i], node.Content[i+1]
+		}
+	}
+	return nil, nil
+}
+
+// mappingKeys returns the key nodes of a mapping node, in the order they appear.
+func mappingKeys(node *yaml.Node) []*yaml.Node {
+	if node == nil || node.Kind != yaml.MappingNode {
+		return nil
+	}
+	var keys []*yaml.Node
+	for i := 0; i < len(node.Content); i += 2 {
+		keys = append(keys, node.Content[i])
+	}
+	return keys
+}
+
+// sequenceItems returns the item nodes of a sequence node.
+func sequenceItems(node *yaml.Node) []*yaml.Node {
+	if node == nil || node.Kind != yaml.SequenceNode {
+		return nil
+	}
+	return node.Content
+}
+
+// joinKeys joins a section and a key with a dot, as in workflow.type.
+func joinKeys(section, key string) string {
+	if section == "" {
+		return key
+	}
+	return section + "." + key
+}
+
+// yamlErrors converts the errors returned by the yaml package when parsing the given file into
+// ValidationErrors, extracting the lines where they occurred.
+func yamlErrors(file string, err error) ValidationErrors {
+	var messages []string
+	var typeErr *yaml.TypeError
+	if errors.As(err, &typeErr) {
+		messages = typeErr.Errors
+	} else {
+		messages = []string{err.Error()}
+	}
+
+	validationErrors := make(ValidationErrors, len(messages))
+	for i, message := range messages {
+		validationErrors[i] = ValidationError{File: file, Message: message}
+		if match := yamlErrorLine.FindStringSubmatch(message); match != nil {
+			validationErrors[i].Line, _ = strconv.Atoi(match[1])
+			validationErrors[i].Message = match[2]
+		}
+	}
+	return validationErrors
+}
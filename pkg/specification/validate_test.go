@@ -0,0 +1,198 @@
+/*
+This file is part of REANA.
+Copyright (C) 2022 CERN.
+
+REANA is free software; you can redistribute it and/or modify it
+under the terms of the MIT License; see LICENSE file for more details.
+*/
+
+package specification
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestValidate(t *testing.T) {
+	tests := map[string]struct {
+		reanaYaml    string
+		workflowFile string
+		wantErrors   []ValidationError
+	}{
+		"valid": {
+			reanaYaml: `
+inputs:
+  parameters:
+    events: 100
+workflow:
+  type: serial
+  specification:
+    steps:
+      - name: gendata
+        environment: docker.io/library/python:3.10-bookworm
+        commands:
+          - python gendata.py --events ${events}
+`,
+		},
+		"syntax error": {
+			reanaYaml: "workflow:\n  type: [serial\nversion: 0.8.0\n",
+			wantErrors: []ValidationError{
+				{File: "reana.yaml", Line: 1, Message: "did not find expected ',' or ']'"},
+			},
+		},
+		"type errors": {
+			reanaYaml: `
+inputs:
+  files: code/main.py
+workflow:
+  type: [serial]
+  specification: {}
+`,
+			wantErrors: []ValidationError{
+				{
+					File:    "reana.yaml",
+					Line:    3,
+					Message: "cannot unmarshal !!str `code/ma...` into []string",
+				},
+				{
+					File:    "reana.yaml",
+					Line:    5,
+					Message: "cannot unmarshal !!seq into string",
+				},
+			},
+		},
+		"empty file": {
+			reanaYaml: "",
+			wantErrors: []ValidationError{
+				{File: "reana.yaml", Message: "specification file is empty"},
+			},
+		},
+		"unknown properties": {
+			reanaYaml: `
+inputs:
+  file: [code/main.py]
+workflow:
+  type: serial
+  specification: {steps: []}
+  engine: serial
+output: {}
+`,
+			wantErrors: []ValidationError{
+				{File: "reana.yaml", Line: 8, Message: "unknown property 'output'"},
+				{File: "reana.yaml", Line: 3, Message: "unknown property 'inputs.file'"},
+				{File: "reana.yaml", Line: 7, Message: "unknown property 'workflow.engine'"},
+			},
+		},
+		"missing workflow": {
+			reanaYaml: "version: 0.8.0\n",
+			wantErrors: []ValidationError{
+				{File: "reana.yaml", Line: 1, Message: "missing required property 'workflow'"},
+			},
+		},
+		"missing workflow type and specification": {
+			reanaYaml: "workflow:\n  resources: {}\n",
+			wantErrors: []ValidationError{
+				{
+					File:    "reana.yaml",
+					Line:    1,
+					Message: "missing required property 'workflow.type'",
+				},
+				{
+					File:    "reana.yaml",
+					Line:    1,
+					Message: "either 'workflow.file' or 'workflow.specification' must be provided",
+				},
+			},
+		},
+		"unsupported type and both file and specification": {
+			reanaYaml: `
+workflow:
+  type: nextflow
+  file: main.nf
+  specification: {}
+`,
+			wantErrors: []ValidationError{
+				{
+					File: "reana.yaml",
+					Line: 3,
+					Message: "invalid value for 'workflow.type': 'nextflow' is not part of " +
+						"'serial', 'cwl', 'yadage', 'snakemake'",
+				},
+				{
+					File: "reana.yaml",
+					Line: 5,
+					Message: "'workflow.file' and 'workflow.specification' " +
+						"cannot be provided together",
+				},
+			},
+		},
+		"missing workflow file": {
+			reanaYaml: "workflow:\n  type: yadage\n  file: missing.yaml\n",
+			wantErrors: []ValidationError{
+				{
+					File: "reana.yaml",
+					Line: 3,
+					Message: "cannot read workflow file 'missing.yaml': " +
+						"open missing.yaml: no such file or directory",
+				},
+			},
+		},
+		"percent sign in workflow file": {
+			reanaYaml: "workflow:\n  type: yadage\n  file: missing%d.yaml\n",
+			wantErrors: []ValidationError{
+				{
+					File: "reana.yaml",
+					Line: 3,
+					Message: "cannot read workflow file 'missing%d.yaml': " +
+						"open missing%d.yaml: no such file or directory",
+				},
+			},
+		},
+		"invalid workflow file": {
+			reanaYaml:    "workflow:\n  type: cwl\n  file: workflow.cwl\n",
+			workflowFile: "cwlVersion: v1.0\nclass: [Workflow\ninputs: []\n",
+			wantErrors: []ValidationError{
+				{File: "workflow.cwl", Line: 1, Message: "did not find expected ',' or ']'"},
+			},
+		},
+		"snakemake workflow file": {
+			reanaYaml:    "workflow:\n  type: snakemake\n  file: workflow.cwl\n",
+			workflowFile: "rule all:\n    input: 'results/plot.png'\n",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			dir := t.TempDir()
+			path := filepath.Join(dir, "reana.yaml")
+			if err := os.WriteFile(path, []byte(test.reanaYaml), 0644); err != nil {
+				t.Fatal(err)
+			}
+			if test.workflowFile != "" {
+				err := os.WriteFile(
+					filepath.Join(dir, "workflow.cwl"),
+					[]byte(test.workflowFile),
+					0644,
+				)
+				if err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			_, errs := Validate(path)
+			if len(errs) != len(test.wantErrors) {
+				t.Fatalf("Expected errors %v, instead got %v", test.wantErrors, errs)
+			}
+			for i, err := range errs {
+				// Make the file paths relative to the temporary directory
+				err.File, _ = filepath.Rel(dir, err.File)
+				err.Message = strings.ReplaceAll(err.Message, dir+string(filepath.Separator), "")
+				if err != test.wantErrors[i] {
+					t.Errorf("Expected error %#v, instead got %#v", test.wantErrors[i], err)
+				}
+			}
+		})
+	}
+}
@@ -56,6 +56,15 @@ func ValidateWorkflow(workflow string) error {
 	return nil
 }
 
+// ValidateWorkflowName verifies if the name given to a new workflow is valid.
+// The name is optional, but it cannot contain dots since they separate the name from the run number.
+func ValidateWorkflowName(name string) error {
+	if strings.Contains(name, ".") {
+		return fmt.Errorf("workflow name '%s' cannot contain dots", name)
+	}
+	return nil
+}
+
 // ValidateChoice verifies if the given argument (arg) is part of the slice of available choices.
 // The third parameter, name, is the name of the argument/flag that should be displayed if the validation fails.
 func ValidateChoice(arg string, choices []string, name string) error {
@@ -154,3 +163,18 @@ func ValidateFile(path string) error {
 	}
 	return nil
 }
+
+// ValidateDirectory verifies if the directory in the given path exists and if it is a directory.
+func ValidateDirectory(path string) error {
+	fileInfo, err := os.Stat(path)
+	if os.IsNotExist(err) {
+		return fmt.Errorf("directory '%s' does not exist", path)
+	}
+	if err != nil {
+		return err
+	}
+	if !fileInfo.IsDir() {
+		return fmt.Errorf("'%s' is not a directory", path)
+	}
+	return nil
+}
@@ -31,6 +31,29 @@ func TestValidateWorkflow(t *testing.T) {
 	testNonEmptyString(t, ValidateWorkflow, InvalidWorkflowMsg)
 }
 
+func TestValidateWorkflowName(t *testing.T) {
+	tests := map[string]struct {
+		name      string
+		wantError bool
+	}{
+		"valid name":    {name: "myanalysis"},
+		"empty name":    {name: ""},
+		"name with dot": {name: "myanalysis.42", wantError: true},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := ValidateWorkflowName(test.name)
+			if test.wantError && err == nil {
+				t.Error("Expected error, instead got nil")
+			}
+			if !test.wantError && err != nil {
+				t.Errorf("Got unexpected error '%s'", err.Error())
+			}
+		})
+	}
+}
+
 func TestValidateChoice(t *testing.T) {
 	choices := []string{"test1", "test2", "test3"}
 
@@ -276,6 +299,49 @@ func TestValidateFile(t *testing.T) {
 	}
 }
 
+func TestValidateDirectory(t *testing.T) {
+	tempDir := t.TempDir()
+	file := tempDir + "/file.txt"
+	if err := os.WriteFile(file, []byte{}, 0644); err != nil {
+		t.Fatalf("Error while creating file: %s", err.Error())
+	}
+
+	tests := map[string]struct {
+		path      string
+		wantError bool
+		expected  string
+	}{
+		"existing directory": {
+			path: tempDir,
+		},
+		"unexisting directory": {
+			path:      "this_doesnt_exist",
+			wantError: true,
+			expected:  "directory 'this_doesnt_exist' does not exist",
+		},
+		"file": {
+			path:      file,
+			wantError: true,
+			expected:  fmt.Sprintf("'%s' is not a directory", file),
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			got := ValidateDirectory(test.path)
+			if test.wantError {
+				if got == nil {
+					t.Errorf("Expected error: %s, got nil", test.expected)
+				} else if got.Error() != test.expected {
+					t.Errorf("Expected error: %s, got %s", test.expected, got.Error())
+				}
+			}
+			if !test.wantError && got != nil {
+				t.Errorf("Unexpected error: %s", got.Error())
+			}
+		})
+	}
+}
+
 func testNonEmptyString(t *testing.T, f func(string) error, errorMsg string) {
 	tests := map[string]struct {
 		arg       string
@@ -9,14 +9,18 @@ under the terms of the MIT License; see LICENSE file for more details.
 package workflows
 
 import (
+	"context"
+	"encoding/json"
 	"reanahub/reana-client-go/client"
 	"reanahub/reana-client-go/client/operations"
 	"reanahub/reana-client-go/pkg/config"
+	"reanahub/reana-client-go/pkg/specification"
 	"reanahub/reana-client-go/pkg/validator"
 )
 
 // UpdateStatus updates the status of the specified workflow.
 func UpdateStatus(
+	ctx context.Context,
 	token, workflow, status string,
 	includeWorkspace, includeAllRuns bool,
 ) error {
@@ -24,20 +28,38 @@ func UpdateStatus(
 		return err
 	}
 
-	deleteParams := operations.NewSetWorkflowStatusParams()
-	deleteParams.SetAccessToken(&token)
-	deleteParams.SetWorkflowIDOrName(workflow)
-	deleteParams.SetStatus(status)
-	deleteParams.SetParameters(operations.SetWorkflowStatusBody{
+	return setStatus(ctx, token, workflow, status, operations.SetWorkflowStatusBody{
 		AllRuns:   includeAllRuns,
 		Workspace: includeWorkspace,
 	})
+}
+
+// Stop stops the specified workflow. The server only supports forced stops for now, which do not
+// wait for the running jobs to finish.
+func Stop(ctx context.Context, token, workflow string, forceStop bool) error {
+	return setStatus(ctx, token, workflow, "stop", operations.SetWorkflowStatusBody{
+		ForceStop: forceStop,
+	})
+}
+
+// setStatus sends the request changing the status of the workflow, which can be one of the run
+// statuses or an action such as "stop", with the given parameters.
+func setStatus(
+	ctx context.Context,
+	token, workflow, status string,
+	parameters operations.SetWorkflowStatusBody,
+) error {
+	statusParams := operations.NewSetWorkflowStatusParamsWithContext(ctx)
+	statusParams.SetAccessToken(&token)
+	statusParams.SetWorkflowIDOrName(workflow)
+	statusParams.SetStatus(status)
+	statusParams.SetParameters(parameters)
 
 	api, err := client.ApiClient()
 	if err != nil {
 		return err
 	}
-	_, err = api.Operations.SetWorkflowStatus(deleteParams)
+	_, err = api.Operations.SetWorkflowStatus(statusParams)
 	if err != nil {
 		return err
 	}
@@ -46,8 +68,11 @@ func UpdateStatus(
 }
 
 // GetStatus returns the status information of the specified workflow.
-func GetStatus(token, workflow string) (*operations.GetWorkflowStatusOKBody, error) {
-	getParams := operations.NewGetWorkflowStatusParams()
+func GetStatus(
+	ctx context.Context,
+	token, workflow string,
+) (*operations.GetWorkflowStatusOKBody, error) {
+	getParams := operations.NewGetWorkflowStatusParamsWithContext(ctx)
 	getParams.SetAccessToken(&token)
 	getParams.SetWorkflowIDOrName(workflow)
 
@@ -62,3 +87,36 @@ func GetStatus(token, workflow string) (*operations.GetWorkflowStatusOKBody, err
 
 	return resp.GetPayload(), nil
 }
+
+// GetSpecification returns the REANA specification the specified workflow was created with.
+func GetSpecification(
+	ctx context.Context,
+	token, workflow string,
+) (*specification.Specification, error) {
+	specParams := operations.NewGetWorkflowSpecificationParamsWithContext(ctx)
+	specParams.SetAccessToken(&token)
+	specParams.SetWorkflowIDOrName(workflow)
+
+	api, err := client.ApiClient()
+	if err != nil {
+		return nil, err
+	}
+	resp, err := api.Operations.GetWorkflowSpecification(specParams)
+	if err != nil {
+		return nil, err
+	}
+
+	// The payload is untyped, so convert it through its JSON representation
+	var payload struct {
+		Specification specification.Specification `json:"specification"`
+	}
+	rawPayload, err := json.Marshal(resp.GetPayload())
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(rawPayload, &payload); err != nil {
+		return nil, err
+	}
+
+	return &payload.Specification, nil
+}
@@ -9,6 +9,7 @@ under the terms of the MIT License; see LICENSE file for more details.
 package workflows
 
 import (
+	"context"
 	"fmt"
 	"reanahub/reana-client-go/pkg/config"
 	"strings"
@@ -16,7 +17,7 @@ import (
 )
 
 func TestUpdateStatus(t *testing.T) {
-	err := UpdateStatus("token", "workflow", "invalid", false, false)
+	err := UpdateStatus(context.Background(), "token", "workflow", "invalid", false, false)
 	if err == nil {
 		t.Errorf("expected %s error, got nil", fmt.Errorf(
 			"invalid value for status: invalid is not part of '%s'",